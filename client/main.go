@@ -22,6 +22,13 @@ var (
 	act  = flag.String("act", "", "Action to perform")
 	file = flag.String("file", "", "File to load")
 	task = flag.String("task", "", "Task name")
+	run  = flag.String("run", "", "Run ID")
+	page = flag.Int64("page", 0, "Page of history (50 runs per page)")
+	name = flag.String("name", "", "Secret or calendar name")
+	val  = flag.String("value", "", "Secret value")
+	schd = flag.String("sched", "", "Schedule to preview")
+	tz   = flag.String("tz", "", "Timezone of schedule")
+	cnt  = flag.Int64("count", 10, "Number of runs to preview")
 )
 
 func main() {
@@ -158,6 +165,30 @@ func main() {
 			log.Fatalf("could not start scheduler: %v", err)
 		}
 		log.Printf("Scheduler started: %v", r.Message)
+	case "pauseScheduler":
+		r, err := c.SchedulerPause(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Scheduler paused: %v", r.Message)
+	case "resumeScheduler":
+		r, err := c.SchedulerResume(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Scheduler resumed: %v", r.Message)
+	case "drainScheduler": // pause and wait until no run is in progress
+		r, err := c.SchedulerDrain(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Scheduler drained: %s", r.GetState())
+	case "statusScheduler":
+		r, err := c.SchedulerStatus(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Scheduler: %s, since: %s, running: %d, queued: %d", r.GetState(), time.UnixMicro(r.GetSince()).Format("2006-01-02 15:04:05"), r.GetRunning(), r.GetQueued())
 	case "watch": // watch for new tasks
 		r, err := c.SchedulerWatch(context.Background(), &pb.Empty{})
 		if err != nil {
@@ -172,25 +203,151 @@ func main() {
 				log.Fatalf("could not watch tasks: %v", err)
 			}
 			fmt.Printf(
-				"tsk: %s, t: %s, Type: %s, Msg: %s\n",
+				"tsk: %s, t: %s, Attempt: %d, Type: %s, Msg: %s\n",
 				msg.GetName(),
 				time.UnixMicro(msg.GetTimestamp()).Format("15:04:05"),
+				msg.GetAttempt(),
 				msg.GetType(),
 				msg.GetMessage())
+			if result := msg.GetResult(); result != nil {
+				fmt.Printf(
+					"tsk: %s, Result: %s, exit: %d, signal: %s, duration: %dms\n",
+					msg.GetName(),
+					result.GetReason(),
+					result.GetExitCode(),
+					result.GetSignal(),
+					result.GetDurationMs())
+			}
 		}
 	case "running":
 		r, err := c.SchedulerRunningTasks(ctx, &pb.Empty{})
 		if err != nil {
 			log.Fatalf("could not get scheduler status: %v", err)
 		}
-		for _, task := range r.GetData() {
-			log.Printf("Task: %v", task)
+		for _, task := range r.GetTasks() {
+			log.Printf("Task: %s, name: %s, priority: %d, running: %d, queued: %d", task.GetUuid(), task.GetName(), task.GetPriority(), task.GetRunning(), task.GetQueued())
+		}
+	case "history": // runs history of task (or single run if -run is set)
+		if *run != "" {
+			r, err := c.RunGet(ctx, &pb.RunID{RunId: *run})
+			if err != nil {
+				log.Fatal(parseError(err))
+			}
+			printRun(r)
+			break
+		}
+		r, err := c.RunList(ctx, &pb.RunFilter{TaskUuid: *task, Offset: *page * 50, Limit: 50})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		for _, run := range r.GetRuns() {
+			printRun(run)
+		}
+		fmt.Printf("total: %d\n", r.GetTotal())
+	case "queue": // runs waiting for free slot
+		r, err := c.QueueList(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		for _, run := range r.GetRuns() {
+			fmt.Printf(
+				"%d. run: %s, tsk: %s, app: %s, priority: %d, queued: %s\n",
+				run.GetPosition(),
+				run.GetRunId(),
+				run.GetName(),
+				run.GetApp(),
+				run.GetPriority(),
+				time.UnixMicro(run.GetQueuedTime()).Format("2006-01-02 15:04:05"))
+		}
+	case "queueCancel":
+		r, err := c.QueueCancel(ctx, &pb.RunID{RunId: *run})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Queued run cancelled: %v", r.Message)
+	case "preview": // next run times of schedule (-sched, -tz) or existing task (-task)
+		r, err := c.ScheduleNextRuns(ctx, &pb.ScheduleRequest{Schedule: *schd, Timezone: *tz, Count: *cnt, TaskUuid: *task})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		loc := time.Local
+		if *tz != "" {
+			if loc, err = time.LoadLocation(*tz); err != nil {
+				loc = time.Local
+			}
+		}
+		for _, next := range r.GetRuns() {
+			fmt.Printf("%s\n", time.UnixMicro(next).In(loc).Format("2006-01-02 15:04:05 MST"))
+		}
+	case "secretSet":
+		r, err := c.SecretSet(ctx, &pb.Secret{Name: *name, Value: *val})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Secret set: %v", r.Message)
+	case "secretDelete":
+		r, err := c.SecretDelete(ctx, &pb.SecretName{Name: *name})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Secret deleted: %v", r.Message)
+	case "secretList":
+		r, err := c.SecretList(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		for _, secret := range r.GetData() {
+			fmt.Printf("%s\n", secret)
+		}
+	case "calendarSet": // create or update calendars from file
+		calendars, err := loadCalendarsFromFile(*file)
+		if err != nil {
+			log.Fatalf("could not load calendars: %v", err)
+		}
+		for _, calendar := range calendars {
+			r, err := c.CalendarSet(ctx, calendar)
+			if err != nil {
+				log.Fatal(parseError(err))
+			}
+			log.Printf("Calendar set: %s %v", calendar.GetName(), r.Message)
+		}
+	case "calendarDelete":
+		r, err := c.CalendarDelete(ctx, &pb.CalendarName{Name: *name})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		log.Printf("Calendar deleted: %v", r.Message)
+	case "calendarList":
+		r, err := c.CalendarList(ctx, &pb.Empty{})
+		if err != nil {
+			log.Fatal(parseError(err))
+		}
+		for _, calendar := range r.GetCalendars() {
+			fmt.Printf("%s: dates: %v, windows: %v, timezone: %s\n", calendar.GetName(), calendar.GetDates(), calendar.GetWindows(), calendar.GetTimezone())
 		}
 	default:
 		log.Fatalf("unknown action: %v", *act)
 	}
 }
 
+func printRun(run *pb.Run) {
+	end := "-"
+	if run.GetEndTime() != 0 {
+		end = time.UnixMicro(run.GetEndTime()).Format("2006-01-02 15:04:05")
+	}
+	fmt.Printf(
+		"run: %s, tsk: %s, trigger: %s, start: %s, end: %s, state: %s, exit: %d, reason: %s, attempts: %d\n",
+		run.GetRunId(),
+		run.GetName(),
+		run.GetTrigger(),
+		time.UnixMicro(run.GetStartTime()).Format("2006-01-02 15:04:05"),
+		end,
+		run.GetState(),
+		run.GetExitCode(),
+		run.GetResult().GetReason(),
+		run.GetAttempts())
+}
+
 func parseError(err error) string {
 	if err == nil {
 		return ""
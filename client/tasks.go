@@ -19,3 +19,15 @@ func loadTasksFromFile(file string) ([]*pb.Task, error) {
 	}
 	return tasks, nil
 }
+
+func loadCalendarsFromFile(file string) ([]*pb.Calendar, error) {
+	calendars := make([]*pb.Calendar, 0)
+	calendarsData, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(calendarsData, &calendars); err != nil {
+		return nil, err
+	}
+	return calendars, nil
+}
@@ -20,6 +20,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RunReason int32
+
+const (
+	RunReason_REASON_UNKNOWN         RunReason = 0 // run not finished yet
+	RunReason_REASON_SUCCESS         RunReason = 1 // process exited with code 0
+	RunReason_REASON_NON_ZERO_EXIT   RunReason = 2 // process exited with non-zero code (or was killed by signal)
+	RunReason_REASON_TIMEOUT         RunReason = 3 // task timeout reached, process killed
+	RunReason_REASON_CANCELLED       RunReason = 4 // task cancelled/force-stopped
+	RunReason_REASON_FAILED_TO_START RunReason = 5 // process could not be started
+	RunReason_REASON_SKIPPED         RunReason = 6 // skipped because task is already running
+	RunReason_REASON_OOM_KILLED      RunReason = 7 // process killed by OOM killer (memory limit reached)
+)
+
+// Enum value maps for RunReason.
+var (
+	RunReason_name = map[int32]string{
+		0: "REASON_UNKNOWN",
+		1: "REASON_SUCCESS",
+		2: "REASON_NON_ZERO_EXIT",
+		3: "REASON_TIMEOUT",
+		4: "REASON_CANCELLED",
+		5: "REASON_FAILED_TO_START",
+		6: "REASON_SKIPPED",
+		7: "REASON_OOM_KILLED",
+	}
+	RunReason_value = map[string]int32{
+		"REASON_UNKNOWN":         0,
+		"REASON_SUCCESS":         1,
+		"REASON_NON_ZERO_EXIT":   2,
+		"REASON_TIMEOUT":         3,
+		"REASON_CANCELLED":       4,
+		"REASON_FAILED_TO_START": 5,
+		"REASON_SKIPPED":         6,
+		"REASON_OOM_KILLED":      7,
+	}
+)
+
+func (x RunReason) Enum() *RunReason {
+	p := new(RunReason)
+	*p = x
+	return p
+}
+
+func (x RunReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_gs_proto_enumTypes[0].Descriptor()
+}
+
+func (RunReason) Type() protoreflect.EnumType {
+	return &file_gs_proto_enumTypes[0]
+}
+
+func (x RunReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunReason.Descriptor instead.
+func (RunReason) EnumDescriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{0}
+}
+
 type Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,18 +268,47 @@ type Task struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                         // Task Name [a-zA-Z0-9_ ] max 128chars
-	Description string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                                           // Task Description [a-zA-Z0-9_ ] max 128chars
-	Tags        map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Tags that can be used for more detailed task description (key:value, saved also to logFile)
-	Schedule    string            `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`                                                                                 // Task Schedule cron expression "* * * * *"
-	Timeout     int64             `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                  // Task Timeout in seconds (must be > 1)
-	App         string            `protobuf:"bytes,6,opt,name=app,proto3" json:"app,omitempty"`                                                                                           // Task App name (must be in apps list gscheduler config.yaml)
-	WorkDir     string            `protobuf:"bytes,7,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`                                                                    // App Workin directory. If empty app will be executed in app directory
-	Args        []string          `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty"`                                                                                         // Task App arguments
-	NextTask    string            `protobuf:"bytes,9,opt,name=next_task,json=nextTask,proto3" json:"next_task,omitempty"`                                                                 // Task UUID of next task to run after this task finished
-	Uuid        string            `protobuf:"bytes,10,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                        // Task UUID (autogenerated on create)
-	CronId      int64             `protobuf:"varint,11,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`                                                                     // Task CronID - interal scheduler ID controlled by application (if 0 task is not scheduled, controlled by app)
-	Enabled     bool              `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                                                 // Task Enabled (controlled by app)
+	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                         // Task Name [a-zA-Z0-9_ ] max 128chars
+	Description       string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                                           // Task Description [a-zA-Z0-9_ ] max 128chars
+	Tags              map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Tags that can be used for more detailed task description (key:value, saved also to logFile)
+	Schedule          string            `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`                                                                                 // Task Schedule cron expression "* * * * *" (H = value spread by hash of task UUID)
+	Timeout           int64             `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                  // Task Timeout in seconds (must be > 1)
+	App               string            `protobuf:"bytes,6,opt,name=app,proto3" json:"app,omitempty"`                                                                                           // Task App name (must be in apps list gscheduler config.yaml)
+	WorkDir           string            `protobuf:"bytes,7,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`                                                                    // App Workin directory. If empty app will be executed in app directory
+	Args              []string          `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty"`                                                                                         // Task App arguments (args, work_dir, env values and stdin are Go text/template if template is set)
+	NextTask          string            `protobuf:"bytes,9,opt,name=next_task,json=nextTask,proto3" json:"next_task,omitempty"`                                                                 // Task UUID of next task to run after this task finished
+	Uuid              string            `protobuf:"bytes,10,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                        // Task UUID (autogenerated on create)
+	CronId            int64             `protobuf:"varint,11,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`                                                                     // Task CronID - interal scheduler ID controlled by application (if 0 task is not scheduled, controlled by app)
+	Enabled           bool              `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                                                 // Task Enabled (controlled by app)
+	Retry             *RetryPolicy      `protobuf:"bytes,13,opt,name=retry,proto3" json:"retry,omitempty"`                                                                                      // Retry policy when task fails (empty = no retry)
+	DependsOn         []*Dependency     `protobuf:"bytes,14,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`                                                             // Workflow - run this task after parent tasks finished
+	OnFailureTask     string            `protobuf:"bytes,15,opt,name=on_failure_task,json=onFailureTask,proto3" json:"on_failure_task,omitempty"`                                               // Task UUID of hook task to run when this task fails (after all retries)
+	OnFinishTask      string            `protobuf:"bytes,16,opt,name=on_finish_task,json=onFinishTask,proto3" json:"on_finish_task,omitempty"`                                                  // Task UUID of hook task to run when this task finished (success or failure)
+	AlertEmails       []string          `protobuf:"bytes,17,rep,name=alert_emails,json=alertEmails,proto3" json:"alert_emails,omitempty"`                                                       // Email recipients of failure alerts (in addition to smtp default recipients)
+	Env               map[string]string `protobuf:"bytes,18,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`  // Environment variables of task (override service and app environment)
+	ConcurrencyPolicy string            `protobuf:"bytes,19,opt,name=concurrency_policy,json=concurrencyPolicy,proto3" json:"concurrency_policy,omitempty"`                                     // When task is already running: skip, queue-one, queue-all, replace, allow (empty = skip)
+	ConcurrencyLimit  int64             `protobuf:"varint,20,opt,name=concurrency_limit,json=concurrencyLimit,proto3" json:"concurrency_limit,omitempty"`                                       // queue-all: max queued runs (0 = 10), allow: max parallel runs (0 = unlimited)
+	Priority          int64             `protobuf:"varint,21,opt,name=priority,proto3" json:"priority,omitempty"`                                                                               // Priority of queued runs (higher runs first, default 0)
+	MisfirePolicy     string            `protobuf:"bytes,22,opt,name=misfire_policy,json=misfirePolicy,proto3" json:"misfire_policy,omitempty"`                                                 // Runs missed while scheduler was not running: ignore, run_once, run_all (empty = ignore)
+	MisfireLimit      int64             `protobuf:"varint,23,opt,name=misfire_limit,json=misfireLimit,proto3" json:"misfire_limit,omitempty"`                                                   // run_all: max number of catch-up runs (0 = 10)
+	MisfireGrace      int64             `protobuf:"varint,24,opt,name=misfire_grace,json=misfireGrace,proto3" json:"misfire_grace,omitempty"`                                                   // Only runs missed within last misfire_grace seconds are caught up (0 = config misfire_grace)
+	Timezone          string            `protobuf:"bytes,25,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                                                // IANA timezone of schedule e.g. "Europe/Prague" (empty = config timezone or server local time)
+	NextRun           int64             `protobuf:"varint,26,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`                                                                  // Next scheduled run of enabled task (unix microseconds, controlled by app - TasksList only)
+	PrevRun           int64             `protobuf:"varint,27,opt,name=prev_run,json=prevRun,proto3" json:"prev_run,omitempty"`                                                                  // Previous scheduled run of enabled task (unix microseconds, controlled by app - TasksList only)
+	ExcludeCalendars  []string          `protobuf:"bytes,28,rep,name=exclude_calendars,json=excludeCalendars,proto3" json:"exclude_calendars,omitempty"`                                        // Calendar names - scheduled run is skipped if its time is in any of calendars
+	IncludeCalendars  []string          `protobuf:"bytes,29,rep,name=include_calendars,json=includeCalendars,proto3" json:"include_calendars,omitempty"`                                        // Calendar names - scheduled run is skipped if its time is not in any of calendars
+	RunAt             string            `protobuf:"bytes,30,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`                                                                         // One-shot run time RFC3339 "2006-01-02T15:04:05Z07:00" (schedule must be empty)
+	StartAfter        string            `protobuf:"bytes,31,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`                                                          // Scheduled runs start at this time RFC3339 (empty = no bound)
+	EndBefore         string            `protobuf:"bytes,32,opt,name=end_before,json=endBefore,proto3" json:"end_before,omitempty"`                                                             // Scheduled runs end before this time RFC3339 (empty = no bound)
+	ExpireAction      string            `protobuf:"bytes,33,opt,name=expire_action,json=expireAction,proto3" json:"expire_action,omitempty"`                                                    // When task has no more scheduled runs (run_at done, end_before passed): disable, delete (empty = disable)
+	Jitter            int64             `protobuf:"varint,34,opt,name=jitter,proto3" json:"jitter,omitempty"`                                                                                   // Max random delay of scheduled run in seconds (0 = no delay, max 86400), doesn't count to timeout
+	StopSignal        string            `protobuf:"bytes,35,opt,name=stop_signal,json=stopSignal,proto3" json:"stop_signal,omitempty"`                                                          // Signal sent to process on timeout/stop e.g. SIGTERM, SIGINT (empty = SIGTERM if kill_grace_period is set, otherwise SIGKILL)
+	KillGracePeriod   int64             `protobuf:"varint,36,opt,name=kill_grace_period,json=killGracePeriod,proto3" json:"kill_grace_period,omitempty"`                                        // Seconds between stop signal and SIGKILL (0 = 10 if stop_signal is set)
+	Limits            *ResourceLimits   `protobuf:"bytes,37,opt,name=limits,proto3" json:"limits,omitempty"`                                                                                    // Resource limits of process (Linux only, override config app_resources)
+	RunAsUser         string            `protobuf:"bytes,38,opt,name=run_as_user,json=runAsUser,proto3" json:"run_as_user,omitempty"`                                                           // Run process as OS user (name or uid, must be in config task_run_as_users, overrides app run_as)
+	RunAsGroup        string            `protobuf:"bytes,39,opt,name=run_as_group,json=runAsGroup,proto3" json:"run_as_group,omitempty"`                                                        // Run process as OS group (name or gid, must be in config task_run_as_groups, overrides app run_as)
+	Stdin             string            `protobuf:"bytes,40,opt,name=stdin,proto3" json:"stdin,omitempty"`                                                                                      // Content piped to process stdin (template like args)
+	Template          bool              `protobuf:"varint,41,opt,name=template,proto3" json:"template,omitempty"`                                                                               // Render args, work_dir, env values and stdin as Go text/template e.g. {{.ScheduledTime.Format "2006-01-02"}} (false = used as they are)
 }
 
 func (x *Task) Reset() {
@@ -334,198 +427,235 @@ func (x *Task) GetEnabled() bool {
 	return false
 }
 
-type Tasks struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Task) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
 
-	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // Task list
+func (x *Task) GetDependsOn() []*Dependency {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
 }
 
-func (x *Tasks) Reset() {
-	*x = Tasks{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetOnFailureTask() string {
+	if x != nil {
+		return x.OnFailureTask
 	}
+	return ""
 }
 
-func (x *Tasks) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetOnFinishTask() string {
+	if x != nil {
+		return x.OnFinishTask
+	}
+	return ""
 }
 
-func (*Tasks) ProtoMessage() {}
+func (x *Task) GetAlertEmails() []string {
+	if x != nil {
+		return x.AlertEmails
+	}
+	return nil
+}
 
-func (x *Tasks) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Tasks.ProtoReflect.Descriptor instead.
-func (*Tasks) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{5}
+func (x *Task) GetConcurrencyPolicy() string {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ""
 }
 
-func (x *Tasks) GetTasks() []*Task {
+func (x *Task) GetConcurrencyLimit() int64 {
 	if x != nil {
-		return x.Tasks
+		return x.ConcurrencyLimit
 	}
-	return nil
+	return 0
 }
 
-type TaskUUID struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Task) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
 
-	Uuid  string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`    // Task UUID
-	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Force task stop
+func (x *Task) GetMisfirePolicy() string {
+	if x != nil {
+		return x.MisfirePolicy
+	}
+	return ""
 }
 
-func (x *TaskUUID) Reset() {
-	*x = TaskUUID{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetMisfireLimit() int64 {
+	if x != nil {
+		return x.MisfireLimit
 	}
+	return 0
 }
 
-func (x *TaskUUID) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetMisfireGrace() int64 {
+	if x != nil {
+		return x.MisfireGrace
+	}
+	return 0
 }
 
-func (*TaskUUID) ProtoMessage() {}
+func (x *Task) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
 
-func (x *TaskUUID) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetNextRun() int64 {
+	if x != nil {
+		return x.NextRun
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TaskUUID.ProtoReflect.Descriptor instead.
-func (*TaskUUID) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{6}
+func (x *Task) GetPrevRun() int64 {
+	if x != nil {
+		return x.PrevRun
+	}
+	return 0
 }
 
-func (x *TaskUUID) GetUuid() string {
+func (x *Task) GetExcludeCalendars() []string {
 	if x != nil {
-		return x.Uuid
+		return x.ExcludeCalendars
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskUUID) GetForce() bool {
+func (x *Task) GetIncludeCalendars() []string {
 	if x != nil {
-		return x.Force
+		return x.IncludeCalendars
 	}
-	return false
+	return nil
 }
 
-type Status struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Task) GetRunAt() string {
+	if x != nil {
+		return x.RunAt
+	}
+	return ""
+}
 
-	Uuid    string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`       // Task UUID
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Task Status Message
-	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`     // Task Error Message
+func (x *Task) GetStartAfter() string {
+	if x != nil {
+		return x.StartAfter
+	}
+	return ""
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetEndBefore() string {
+	if x != nil {
+		return x.EndBefore
 	}
+	return ""
 }
 
-func (x *Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetExpireAction() string {
+	if x != nil {
+		return x.ExpireAction
+	}
+	return ""
 }
 
-func (*Status) ProtoMessage() {}
+func (x *Task) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetStopSignal() string {
+	if x != nil {
+		return x.StopSignal
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{7}
+func (x *Task) GetKillGracePeriod() int64 {
+	if x != nil {
+		return x.KillGracePeriod
+	}
+	return 0
 }
 
-func (x *Status) GetUuid() string {
+func (x *Task) GetLimits() *ResourceLimits {
 	if x != nil {
-		return x.Uuid
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Task) GetRunAsUser() string {
+	if x != nil {
+		return x.RunAsUser
 	}
 	return ""
 }
 
-func (x *Status) GetMessage() string {
+func (x *Task) GetRunAsGroup() string {
 	if x != nil {
-		return x.Message
+		return x.RunAsGroup
 	}
 	return ""
 }
 
-func (x *Status) GetError() string {
+func (x *Task) GetStdin() string {
 	if x != nil {
-		return x.Error
+		return x.Stdin
 	}
 	return ""
 }
 
-type ExecStatus struct {
+func (x *Task) GetTemplate() bool {
+	if x != nil {
+		return x.Template
+	}
+	return false
+}
+
+type Dependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stderr   string `protobuf:"bytes,1,opt,name=stderr,proto3" json:"stderr,omitempty"`                      // stderr output
-	Stdout   string `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`                      // stdout output
-	ExitCode int64  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // exit code
+	TaskUuid  string `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"` // Parent task UUID
+	Condition string `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`               // on_success, on_failure, always (empty = on_success)
 }
 
-func (x *ExecStatus) Reset() {
-	*x = ExecStatus{}
+func (x *Dependency) Reset() {
+	*x = Dependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[8]
+		mi := &file_gs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecStatus) String() string {
+func (x *Dependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecStatus) ProtoMessage() {}
+func (*Dependency) ProtoMessage() {}
 
-func (x *ExecStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[8]
+func (x *Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,62 +666,55 @@ func (x *ExecStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecStatus.ProtoReflect.Descriptor instead.
-func (*ExecStatus) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Dependency.ProtoReflect.Descriptor instead.
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExecStatus) GetStderr() string {
+func (x *Dependency) GetTaskUuid() string {
 	if x != nil {
-		return x.Stderr
+		return x.TaskUuid
 	}
 	return ""
 }
 
-func (x *ExecStatus) GetStdout() string {
+func (x *Dependency) GetCondition() string {
 	if x != nil {
-		return x.Stdout
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *ExecStatus) GetExitCode() int64 {
-	if x != nil {
-		return x.ExitCode
-	}
-	return 0
-}
-
-type TaskLog struct {
+type ResourceLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                         // task name
-	Tags      map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Tags that can be used for more detailed task description (key:value, saved also to logFile)
-	Uuid      string            `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                         // task uuid autogenerated by scheduler
-	Type      string            `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                                                                         // info, stdout, stderr, exitStatus, error
-	Message   string            `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                                                                   // task log message
-	Timestamp int64             `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                              // current timestamp
+	MemoryMb   int64 `protobuf:"varint,1,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`       // Max memory in MB (process is OOM killed if reached)
+	CpuPercent int64 `protobuf:"varint,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"` // CPU quota in percent of single CPU (200 = 2 CPUs), cgroup only
+	CpuWeight  int64 `protobuf:"varint,3,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight,omitempty"`    // CPU share 1-10000 (default of system 100), cgroup only
+	OpenFiles  int64 `protobuf:"varint,4,opt,name=open_files,json=openFiles,proto3" json:"open_files,omitempty"`    // Max open files
+	Processes  int64 `protobuf:"varint,5,opt,name=processes,proto3" json:"processes,omitempty"`                     // Max processes/threads
+	Nice       int64 `protobuf:"varint,6,opt,name=nice,proto3" json:"nice,omitempty"`                               // Nice level -20 (highest priority) to 19
 }
 
-func (x *TaskLog) Reset() {
-	*x = TaskLog{}
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[9]
+		mi := &file_gs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskLog) String() string {
+func (x *ResourceLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskLog) ProtoMessage() {}
+func (*ResourceLimits) ProtoMessage() {}
 
-func (x *TaskLog) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[9]
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,78 +725,1686 @@ func (x *TaskLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskLog.ProtoReflect.Descriptor instead.
-func (*TaskLog) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TaskLog) GetName() string {
+func (x *ResourceLimits) GetMemoryMb() int64 {
 	if x != nil {
-		return x.Name
+		return x.MemoryMb
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskLog) GetTags() map[string]string {
+func (x *ResourceLimits) GetCpuPercent() int64 {
 	if x != nil {
-		return x.Tags
+		return x.CpuPercent
 	}
-	return nil
+	return 0
 }
 
-func (x *TaskLog) GetUuid() string {
+func (x *ResourceLimits) GetCpuWeight() int64 {
 	if x != nil {
-		return x.Uuid
+		return x.CpuWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskLog) GetType() string {
+func (x *ResourceLimits) GetOpenFiles() int64 {
 	if x != nil {
-		return x.Type
+		return x.OpenFiles
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskLog) GetMessage() string {
+func (x *ResourceLimits) GetProcesses() int64 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetNice() int64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAttempts int64   `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`  // Max attempts including first run (0 or 1 = no retry)
+	Backoff     string  `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`                              // fixed, exponential (empty = fixed)
+	Delay       int64   `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`                                 // Delay before retry in seconds (exponential: delay * 2^(attempt-1), max 86400)
+	MaxDelay    int64   `protobuf:"varint,4,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`           // Max delay in seconds for exponential backoff (0 = no limit, max 86400)
+	Jitter      int64   `protobuf:"varint,5,opt,name=jitter,proto3" json:"jitter,omitempty"`                               // Max random delay in seconds added to each retry delay (max 86400)
+	ExitCodes   []int64 `protobuf:"varint,6,rep,packed,name=exit_codes,json=exitCodes,proto3" json:"exit_codes,omitempty"` // Retry only on these exit codes (empty = any non-zero exit code)
+	OnTimeout   bool    `protobuf:"varint,7,opt,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`        // Retry also when task timed out
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() string {
+	if x != nil {
+		return x.Backoff
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelay() int64 {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetExitCodes() []int64 {
+	if x != nil {
+		return x.ExitCodes
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetOnTimeout() bool {
+	if x != nil {
+		return x.OnTimeout
+	}
+	return false
+}
+
+type Tasks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // Task list
+}
+
+func (x *Tasks) Reset() {
+	*x = Tasks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tasks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tasks) ProtoMessage() {}
+
+func (x *Tasks) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tasks.ProtoReflect.Descriptor instead.
+func (*Tasks) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tasks) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskUUID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid  string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`    // Task UUID
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // Force task stop
+}
+
+func (x *TaskUUID) Reset() {
+	*x = TaskUUID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskUUID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUUID) ProtoMessage() {}
+
+func (x *TaskUUID) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUUID.ProtoReflect.Descriptor instead.
+func (*TaskUUID) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TaskUUID) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TaskUUID) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`       // Task UUID
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Task Status Message
+	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`     // Task Error Message
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Status) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Status) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
-	return ""
+	return ""
+}
+
+func (x *Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExecStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stderr   string     `protobuf:"bytes,1,opt,name=stderr,proto3" json:"stderr,omitempty"`                      // stderr output
+	Stdout   string     `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`                      // stdout output
+	ExitCode int64      `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // exit code
+	Result   *RunResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`                      // structured result
+}
+
+func (x *ExecStatus) Reset() {
+	*x = ExecStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecStatus) ProtoMessage() {}
+
+func (x *ExecStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecStatus.ProtoReflect.Descriptor instead.
+func (*ExecStatus) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExecStatus) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *ExecStatus) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ExecStatus) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecStatus) GetResult() *RunResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode   int64     `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`       // process exit code (-1 if process did not exit normally)
+	Signal     string    `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`                            // name of signal that terminated process (if any)
+	DurationMs int64     `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // run duration in milliseconds
+	Reason     RunReason `protobuf:"varint,4,opt,name=reason,proto3,enum=gscheduler.RunReason" json:"reason,omitempty"` // termination reason
+}
+
+func (x *RunResult) Reset() {
+	*x = RunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResult) ProtoMessage() {}
+
+func (x *RunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResult.ProtoReflect.Descriptor instead.
+func (*RunResult) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RunResult) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *RunResult) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *RunResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *RunResult) GetReason() RunReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RunReason_REASON_UNKNOWN
+}
+
+type TaskLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                         // task name
+	Tags      map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Tags that can be used for more detailed task description (key:value, saved also to logFile)
+	Uuid      string            `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                         // task uuid autogenerated by scheduler
+	Type      string            `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                                                                         // info, stdout, stderr, exitStatus, error
+	Message   string            `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                                                                   // task log message
+	Timestamp int64             `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                              // current timestamp
+	RunId     string            `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                                                                          // run ID of task execution (empty for non-task events)
+	Result    *RunResult        `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`                                                                                     // final result of run (set only on last message of run e.g. exitStatus)
+	Attempt   int64             `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`                                                                                  // attempt number of run (starts at 1)
+}
+
+func (x *TaskLog) Reset() {
+	*x = TaskLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskLog) ProtoMessage() {}
+
+func (x *TaskLog) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskLog.ProtoReflect.Descriptor instead.
+func (*TaskLog) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TaskLog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskLog) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskLog) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TaskLog) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TaskLog) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TaskLog) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TaskLog) GetResult() *RunResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TaskLog) GetAttempt() int64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type Run struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId      string     `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                 // Run ID (autogenerated for each task execution)
+	TaskUuid   string     `protobuf:"bytes,2,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`        // Task UUID
+	Name       string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // Task name
+	Trigger    string     `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`                          // cron, taskRun, nextTask, workflow, onFailure, onFinish
+	State      string     `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                              // running, success, failed, error, skipped, aborted
+	StartTime  int64      `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`    // start timestamp (UnixMicro)
+	EndTime    int64      `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`          // end timestamp (UnixMicro, 0 while running)
+	ExitCode   int64      `protobuf:"varint,8,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`       // process exit code (-1 if process did not exit)
+	Result     *RunResult `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`                            // structured result (empty while running)
+	Attempts   int64      `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`                      // number of attempts (retries + 1)
+	WorkflowId string     `protobuf:"bytes,11,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"` // run ID of workflow root task (same as run_id for root)
+	StderrTail string     `protobuf:"bytes,12,opt,name=stderr_tail,json=stderrTail,proto3" json:"stderr_tail,omitempty"` // last 4kB of stderr output
+}
+
+func (x *Run) Reset() {
+	*x = Run{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Run) ProtoMessage() {}
+
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Run) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Run) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *Run) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Run) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *Run) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Run) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Run) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Run) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *Run) GetResult() *RunResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Run) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Run) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Run) GetStderrTail() string {
+	if x != nil {
+		return x.StderrTail
+	}
+	return ""
+}
+
+type Runs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs  []*Run `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`    // Runs (newest first)
+	Total int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Total number of runs matching filter
+}
+
+func (x *Runs) Reset() {
+	*x = Runs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Runs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Runs) ProtoMessage() {}
+
+func (x *Runs) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Runs.ProtoReflect.Descriptor instead.
+func (*Runs) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Runs) GetRuns() []*Run {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *Runs) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RunFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskUuid string `protobuf:"bytes,1,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"` // Task UUID (empty = all tasks)
+	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                    // Skip first N runs
+	Limit    int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // Max runs returned (0 = 50)
+}
+
+func (x *RunFilter) Reset() {
+	*x = RunFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunFilter) ProtoMessage() {}
+
+func (x *RunFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunFilter.ProtoReflect.Descriptor instead.
+func (*RunFilter) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RunFilter) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *RunFilter) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RunFilter) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RunID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // Run ID
+}
+
+func (x *RunID) Reset() {
+	*x = RunID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunID) ProtoMessage() {}
+
+func (x *RunID) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunID.ProtoReflect.Descriptor instead.
+func (*RunID) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RunID) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type Stop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"` // stop type
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Stop) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type QueuedRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId      string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                 // Run ID
+	TaskUuid   string `protobuf:"bytes,2,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"`        // Task UUID
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // Task name
+	App        string `protobuf:"bytes,4,opt,name=app,proto3" json:"app,omitempty"`                                  // Task app
+	Priority   int64  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`                       // Priority of run (higher runs first)
+	QueuedTime int64  `protobuf:"varint,6,opt,name=queued_time,json=queuedTime,proto3" json:"queued_time,omitempty"` // Time when run was queued (unix microseconds)
+	Position   int64  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`                       // Position in queue (1 = next)
+}
+
+func (x *QueuedRun) Reset() {
+	*x = QueuedRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedRun) ProtoMessage() {}
+
+func (x *QueuedRun) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedRun.ProtoReflect.Descriptor instead.
+func (*QueuedRun) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueuedRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *QueuedRun) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+func (x *QueuedRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueuedRun) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *QueuedRun) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *QueuedRun) GetQueuedTime() int64 {
+	if x != nil {
+		return x.QueuedTime
+	}
+	return 0
+}
+
+func (x *QueuedRun) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type Queue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*QueuedRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"` // Runs waiting for free slot (global max_concurrent_runs or app_limits)
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Queue) GetRuns() []*QueuedRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type RunningTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid     string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`          // Task UUID
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`          // Task name
+	Priority int64  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"` // Task priority
+	Running  int64  `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`   // Number of running runs
+	Queued   int64  `protobuf:"varint,5,opt,name=queued,proto3" json:"queued,omitempty"`     // Number of runs waiting for running run (concurrency policy)
+}
+
+func (x *RunningTask) Reset() {
+	*x = RunningTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunningTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunningTask) ProtoMessage() {}
+
+func (x *RunningTask) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunningTask.ProtoReflect.Descriptor instead.
+func (*RunningTask) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RunningTask) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RunningTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunningTask) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RunningTask) GetRunning() int64 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *RunningTask) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+type RunningTasks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data  []string       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`   // UUIDs of running tasks (compatible with List)
+	Tasks []*RunningTask `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"` // Running tasks details
+}
+
+func (x *RunningTasks) Reset() {
+	*x = RunningTasks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunningTasks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunningTasks) ProtoMessage() {}
+
+func (x *RunningTasks) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunningTasks.ProtoReflect.Descriptor instead.
+func (*RunningTasks) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunningTasks) GetData() []string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RunningTasks) GetTasks() []*RunningTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`                 // Schedule to preview (ignored if task_uuid is set)
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`                 // Timezone of schedule (empty = config timezone or server local time)
+	Count    int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                      // Number of runs (default 10, max 1000)
+	TaskUuid string `protobuf:"bytes,4,opt,name=task_uuid,json=taskUuid,proto3" json:"task_uuid,omitempty"` // Preview schedule of existing task
+}
+
+func (x *ScheduleRequest) Reset() {
+	*x = ScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRequest) ProtoMessage() {}
+
+func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduleRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ScheduleRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ScheduleRequest) GetTaskUuid() string {
+	if x != nil {
+		return x.TaskUuid
+	}
+	return ""
+}
+
+type ScheduleRuns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []int64 `protobuf:"varint,1,rep,packed,name=runs,proto3" json:"runs,omitempty"` // Next run times (unix microseconds)
+}
+
+func (x *ScheduleRuns) Reset() {
+	*x = ScheduleRuns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleRuns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRuns) ProtoMessage() {}
+
+func (x *ScheduleRuns) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRuns.ProtoReflect.Descriptor instead.
+func (*ScheduleRuns) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScheduleRuns) GetRuns() []int64 {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type SchedulerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State   string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`      // running, paused, draining, stopped
+	Running int64  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"` // Runs in progress (including runs waiting for free slot of dispatcher)
+	Queued  int64  `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`   // Runs waiting by task concurrency policy
+	Since   int64  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`     // Time of last state change (unix microseconds)
+}
+
+func (x *SchedulerState) Reset() {
+	*x = SchedulerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerState) ProtoMessage() {}
+
+func (x *SchedulerState) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerState.ProtoReflect.Descriptor instead.
+func (*SchedulerState) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SchedulerState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SchedulerState) GetRunning() int64 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *SchedulerState) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *SchedulerState) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // Secret name [A-Za-z0-9_.-] referenced in task args/env as ${secret:name}
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Secret value (write only - never returned by server)
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SecretName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SecretName) Reset() {
+	*x = SecretName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretName) ProtoMessage() {}
+
+func (x *SecretName) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretName.ProtoReflect.Descriptor instead.
+func (*SecretName) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SecretName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Calendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Calendar name [A-Za-z0-9_.-] max 128chars
+	Description string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Dates       []string          `protobuf:"bytes,3,rep,name=dates,proto3" json:"dates,omitempty"`       // Whole days "2006-01-02"
+	Windows     []*CalendarWindow `protobuf:"bytes,4,rep,name=windows,proto3" json:"windows,omitempty"`   // Recurring windows e.g. maintenance
+	Timezone    string            `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"` // Timezone of dates and windows (empty = config timezone or server local time)
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Calendar) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *Calendar) GetWindows() []*CalendarWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *Calendar) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CalendarWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`        // Schedule of window start (cron expression) e.g. "0 22 * * SAT"
+	Duration int64  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"` // Window duration in seconds
+}
+
+func (x *CalendarWindow) Reset() {
+	*x = CalendarWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarWindow) ProtoMessage() {}
+
+func (x *CalendarWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarWindow.ProtoReflect.Descriptor instead.
+func (*CalendarWindow) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CalendarWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CalendarWindow) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type Calendars struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendars []*Calendar `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+}
+
+func (x *Calendars) Reset() {
+	*x = Calendars{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gs_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calendars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendars) ProtoMessage() {}
+
+func (x *Calendars) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *TaskLog) GetTimestamp() int64 {
+// Deprecated: Use Calendars.ProtoReflect.Descriptor instead.
+func (*Calendars) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Calendars) GetCalendars() []*Calendar {
 	if x != nil {
-		return x.Timestamp
+		return x.Calendars
 	}
-	return 0
+	return nil
 }
 
-type Stop struct {
+type CalendarName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"` // stop type
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Stop) Reset() {
-	*x = Stop{}
+func (x *CalendarName) Reset() {
+	*x = CalendarName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gs_proto_msgTypes[10]
+		mi := &file_gs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stop) String() string {
+func (x *CalendarName) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stop) ProtoMessage() {}
+func (*CalendarName) ProtoMessage() {}
 
-func (x *Stop) ProtoReflect() protoreflect.Message {
-	mi := &file_gs_proto_msgTypes[10]
+func (x *CalendarName) ProtoReflect() protoreflect.Message {
+	mi := &file_gs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,16 +2415,16 @@ func (x *Stop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
-func (*Stop) Descriptor() ([]byte, []int) {
-	return file_gs_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CalendarName.ProtoReflect.Descriptor instead.
+func (*CalendarName) Descriptor() ([]byte, []int) {
+	return file_gs_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Stop) GetForce() bool {
+func (x *CalendarName) GetName() string {
 	if x != nil {
-		return x.Force
+		return x.Name
 	}
-	return false
+	return ""
 }
 
 var File_gs_proto protoreflect.FileDescriptor
@@ -706,7 +2437,7 @@ var file_gs_proto_rawDesc = []byte{
 	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
 	0x20, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
 	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x80, 0x03, 0x0a, 0x04, 0x54,
+	0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xda, 0x0b, 0x0a, 0x04, 0x54,
 	0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
@@ -727,97 +2458,390 @@ var file_gs_proto_rawDesc = []byte{
 	0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x06, 0x63, 0x72, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
 	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a,
-	0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x34,
-	0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x59, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xe9, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67,
-	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1c, 0x0a, 0x04, 0x53, 0x74, 0x6f,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x32, 0xd1, 0x06, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x54, 0x61,
-	0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
-	0x12, 0x34, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10,
-	0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
-	0x12, 0x36, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x14, 0x2e, 0x67,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6e, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x65,
+	0x6e, 0x76, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x66, 0x69, 0x72,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x66, 0x69, 0x72, 0x65, 0x47, 0x72, 0x61, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76,
+	0x52, 0x75, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x75, 0x6e, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6b,
+	0x69, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x73, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x41, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x70, 0x75, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x69, 0x63,
+	0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x2f, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x26, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x34, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x4c,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a,
+	0x0a, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc9, 0x02, 0x0a, 0x07, 0x54,
+	0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x2e, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x75, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x1a, 0x37, 0x0a,
+	0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x02, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x54, 0x61, 0x69, 0x6c, 0x22, 0x41, 0x0a, 0x04, 0x52, 0x75,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75,
+	0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x56, 0x0a,
+	0x09, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x61, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x1e, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x52, 0x75,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73,
+	0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x29, 0x0a,
+	0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x52,
+	0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x51,
+	0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x22, 0x7c, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x22,
+	0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x72,
+	0x75, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x08, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x42, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x09,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0xbe, 0x01,
+	0x0a, 0x09, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x4b,
+	0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x4f, 0x4d, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x32, 0xda,
+	0x0d, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x08, 0x41, 0x70, 0x70, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e,
+	0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49,
+	0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x67,
 	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55,
 	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x2e, 0x67,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x75, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
+	0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x11, 0x2e,
+	0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x18, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x07,
+	0x45, 0x78, 0x65, 0x63, 0x43, 0x6d, 0x64, 0x12, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x16, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11,
+	0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x47, 0x65, 0x74, 0x12,
+	0x13, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x06, 0x52, 0x75, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x75,
+	0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12,
+	0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6e,
 	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x10,
-	0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x67, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x3e, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x67, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x35, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6d, 0x64, 0x12, 0x10, 0x2e, 0x67, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x16, 0x2e, 0x67,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x47,
-	0x65, 0x74, 0x12, 0x13, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x42, 0x28, 0x5a, 0x26, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x53, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x67, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10,
+	0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x65,
+	0x74, 0x12, 0x14, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x18, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x12, 0x2e, 0x67, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x11, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x15, 0x2e, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x22, 0x00, 0x42, 0x28, 0x5a, 0x26, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6d, 0x61, 0x6c, 0x63, 0x65,
 	0x6b, 0x2f, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -835,61 +2859,128 @@ func file_gs_proto_rawDescGZIP() []byte {
 	return file_gs_proto_rawDescData
 }
 
-var file_gs_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_gs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gs_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_gs_proto_goTypes = []interface{}{
-	(*Request)(nil),    // 0: gscheduler.Request
-	(*List)(nil),       // 1: gscheduler.List
-	(*File)(nil),       // 2: gscheduler.File
-	(*Empty)(nil),      // 3: gscheduler.Empty
-	(*Task)(nil),       // 4: gscheduler.Task
-	(*Tasks)(nil),      // 5: gscheduler.Tasks
-	(*TaskUUID)(nil),   // 6: gscheduler.TaskUUID
-	(*Status)(nil),     // 7: gscheduler.Status
-	(*ExecStatus)(nil), // 8: gscheduler.ExecStatus
-	(*TaskLog)(nil),    // 9: gscheduler.TaskLog
-	(*Stop)(nil),       // 10: gscheduler.Stop
-	nil,                // 11: gscheduler.Task.TagsEntry
-	nil,                // 12: gscheduler.TaskLog.TagsEntry
+	(RunReason)(0),          // 0: gscheduler.RunReason
+	(*Request)(nil),         // 1: gscheduler.Request
+	(*List)(nil),            // 2: gscheduler.List
+	(*File)(nil),            // 3: gscheduler.File
+	(*Empty)(nil),           // 4: gscheduler.Empty
+	(*Task)(nil),            // 5: gscheduler.Task
+	(*Dependency)(nil),      // 6: gscheduler.Dependency
+	(*ResourceLimits)(nil),  // 7: gscheduler.ResourceLimits
+	(*RetryPolicy)(nil),     // 8: gscheduler.RetryPolicy
+	(*Tasks)(nil),           // 9: gscheduler.Tasks
+	(*TaskUUID)(nil),        // 10: gscheduler.TaskUUID
+	(*Status)(nil),          // 11: gscheduler.Status
+	(*ExecStatus)(nil),      // 12: gscheduler.ExecStatus
+	(*RunResult)(nil),       // 13: gscheduler.RunResult
+	(*TaskLog)(nil),         // 14: gscheduler.TaskLog
+	(*Run)(nil),             // 15: gscheduler.Run
+	(*Runs)(nil),            // 16: gscheduler.Runs
+	(*RunFilter)(nil),       // 17: gscheduler.RunFilter
+	(*RunID)(nil),           // 18: gscheduler.RunID
+	(*Stop)(nil),            // 19: gscheduler.Stop
+	(*QueuedRun)(nil),       // 20: gscheduler.QueuedRun
+	(*Queue)(nil),           // 21: gscheduler.Queue
+	(*RunningTask)(nil),     // 22: gscheduler.RunningTask
+	(*RunningTasks)(nil),    // 23: gscheduler.RunningTasks
+	(*ScheduleRequest)(nil), // 24: gscheduler.ScheduleRequest
+	(*ScheduleRuns)(nil),    // 25: gscheduler.ScheduleRuns
+	(*SchedulerState)(nil),  // 26: gscheduler.SchedulerState
+	(*Secret)(nil),          // 27: gscheduler.Secret
+	(*SecretName)(nil),      // 28: gscheduler.SecretName
+	(*Calendar)(nil),        // 29: gscheduler.Calendar
+	(*CalendarWindow)(nil),  // 30: gscheduler.CalendarWindow
+	(*Calendars)(nil),       // 31: gscheduler.Calendars
+	(*CalendarName)(nil),    // 32: gscheduler.CalendarName
+	nil,                     // 33: gscheduler.Task.TagsEntry
+	nil,                     // 34: gscheduler.Task.EnvEntry
+	nil,                     // 35: gscheduler.TaskLog.TagsEntry
 }
 var file_gs_proto_depIdxs = []int32{
-	11, // 0: gscheduler.Task.tags:type_name -> gscheduler.Task.TagsEntry
-	4,  // 1: gscheduler.Tasks.tasks:type_name -> gscheduler.Task
-	12, // 2: gscheduler.TaskLog.tags:type_name -> gscheduler.TaskLog.TagsEntry
-	3,  // 3: gscheduler.TaskManager.AppsList:input_type -> gscheduler.Empty
-	4,  // 4: gscheduler.TaskManager.TaskCreate:input_type -> gscheduler.Task
-	4,  // 5: gscheduler.TaskManager.TaskUpdate:input_type -> gscheduler.Task
-	6,  // 6: gscheduler.TaskManager.TaskDelete:input_type -> gscheduler.TaskUUID
-	6,  // 7: gscheduler.TaskManager.TaskStop:input_type -> gscheduler.TaskUUID
-	6,  // 8: gscheduler.TaskManager.TaskStart:input_type -> gscheduler.TaskUUID
-	6,  // 9: gscheduler.TaskManager.TaskRun:input_type -> gscheduler.TaskUUID
-	3,  // 10: gscheduler.TaskManager.TasksList:input_type -> gscheduler.Empty
-	10, // 11: gscheduler.TaskManager.SchedulerStop:input_type -> gscheduler.Stop
-	3,  // 12: gscheduler.TaskManager.SchedulerStart:input_type -> gscheduler.Empty
-	3,  // 13: gscheduler.TaskManager.SchedulerWatch:input_type -> gscheduler.Empty
-	3,  // 14: gscheduler.TaskManager.SchedulerRunningTasks:input_type -> gscheduler.Empty
-	4,  // 15: gscheduler.TaskManager.ExecCmd:input_type -> gscheduler.Task
-	3,  // 16: gscheduler.TaskManager.LogList:input_type -> gscheduler.Empty
-	0,  // 17: gscheduler.TaskManager.LogGet:input_type -> gscheduler.Request
-	1,  // 18: gscheduler.TaskManager.AppsList:output_type -> gscheduler.List
-	7,  // 19: gscheduler.TaskManager.TaskCreate:output_type -> gscheduler.Status
-	7,  // 20: gscheduler.TaskManager.TaskUpdate:output_type -> gscheduler.Status
-	7,  // 21: gscheduler.TaskManager.TaskDelete:output_type -> gscheduler.Status
-	7,  // 22: gscheduler.TaskManager.TaskStop:output_type -> gscheduler.Status
-	7,  // 23: gscheduler.TaskManager.TaskStart:output_type -> gscheduler.Status
-	7,  // 24: gscheduler.TaskManager.TaskRun:output_type -> gscheduler.Status
-	5,  // 25: gscheduler.TaskManager.TasksList:output_type -> gscheduler.Tasks
-	7,  // 26: gscheduler.TaskManager.SchedulerStop:output_type -> gscheduler.Status
-	7,  // 27: gscheduler.TaskManager.SchedulerStart:output_type -> gscheduler.Status
-	9,  // 28: gscheduler.TaskManager.SchedulerWatch:output_type -> gscheduler.TaskLog
-	1,  // 29: gscheduler.TaskManager.SchedulerRunningTasks:output_type -> gscheduler.List
-	8,  // 30: gscheduler.TaskManager.ExecCmd:output_type -> gscheduler.ExecStatus
-	1,  // 31: gscheduler.TaskManager.LogList:output_type -> gscheduler.List
-	2,  // 32: gscheduler.TaskManager.LogGet:output_type -> gscheduler.File
-	18, // [18:33] is the sub-list for method output_type
-	3,  // [3:18] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	33, // 0: gscheduler.Task.tags:type_name -> gscheduler.Task.TagsEntry
+	8,  // 1: gscheduler.Task.retry:type_name -> gscheduler.RetryPolicy
+	6,  // 2: gscheduler.Task.depends_on:type_name -> gscheduler.Dependency
+	34, // 3: gscheduler.Task.env:type_name -> gscheduler.Task.EnvEntry
+	7,  // 4: gscheduler.Task.limits:type_name -> gscheduler.ResourceLimits
+	5,  // 5: gscheduler.Tasks.tasks:type_name -> gscheduler.Task
+	13, // 6: gscheduler.ExecStatus.result:type_name -> gscheduler.RunResult
+	0,  // 7: gscheduler.RunResult.reason:type_name -> gscheduler.RunReason
+	35, // 8: gscheduler.TaskLog.tags:type_name -> gscheduler.TaskLog.TagsEntry
+	13, // 9: gscheduler.TaskLog.result:type_name -> gscheduler.RunResult
+	13, // 10: gscheduler.Run.result:type_name -> gscheduler.RunResult
+	15, // 11: gscheduler.Runs.runs:type_name -> gscheduler.Run
+	20, // 12: gscheduler.Queue.runs:type_name -> gscheduler.QueuedRun
+	22, // 13: gscheduler.RunningTasks.tasks:type_name -> gscheduler.RunningTask
+	30, // 14: gscheduler.Calendar.windows:type_name -> gscheduler.CalendarWindow
+	29, // 15: gscheduler.Calendars.calendars:type_name -> gscheduler.Calendar
+	4,  // 16: gscheduler.TaskManager.AppsList:input_type -> gscheduler.Empty
+	5,  // 17: gscheduler.TaskManager.TaskCreate:input_type -> gscheduler.Task
+	5,  // 18: gscheduler.TaskManager.TaskUpdate:input_type -> gscheduler.Task
+	10, // 19: gscheduler.TaskManager.TaskDelete:input_type -> gscheduler.TaskUUID
+	10, // 20: gscheduler.TaskManager.TaskStop:input_type -> gscheduler.TaskUUID
+	10, // 21: gscheduler.TaskManager.TaskStart:input_type -> gscheduler.TaskUUID
+	10, // 22: gscheduler.TaskManager.TaskRun:input_type -> gscheduler.TaskUUID
+	4,  // 23: gscheduler.TaskManager.TasksList:input_type -> gscheduler.Empty
+	19, // 24: gscheduler.TaskManager.SchedulerStop:input_type -> gscheduler.Stop
+	4,  // 25: gscheduler.TaskManager.SchedulerStart:input_type -> gscheduler.Empty
+	4,  // 26: gscheduler.TaskManager.SchedulerPause:input_type -> gscheduler.Empty
+	4,  // 27: gscheduler.TaskManager.SchedulerResume:input_type -> gscheduler.Empty
+	4,  // 28: gscheduler.TaskManager.SchedulerDrain:input_type -> gscheduler.Empty
+	4,  // 29: gscheduler.TaskManager.SchedulerStatus:input_type -> gscheduler.Empty
+	4,  // 30: gscheduler.TaskManager.SchedulerWatch:input_type -> gscheduler.Empty
+	4,  // 31: gscheduler.TaskManager.SchedulerRunningTasks:input_type -> gscheduler.Empty
+	5,  // 32: gscheduler.TaskManager.ExecCmd:input_type -> gscheduler.Task
+	4,  // 33: gscheduler.TaskManager.LogList:input_type -> gscheduler.Empty
+	1,  // 34: gscheduler.TaskManager.LogGet:input_type -> gscheduler.Request
+	17, // 35: gscheduler.TaskManager.RunList:input_type -> gscheduler.RunFilter
+	18, // 36: gscheduler.TaskManager.RunGet:input_type -> gscheduler.RunID
+	24, // 37: gscheduler.TaskManager.ScheduleNextRuns:input_type -> gscheduler.ScheduleRequest
+	4,  // 38: gscheduler.TaskManager.QueueList:input_type -> gscheduler.Empty
+	18, // 39: gscheduler.TaskManager.QueueCancel:input_type -> gscheduler.RunID
+	27, // 40: gscheduler.TaskManager.SecretSet:input_type -> gscheduler.Secret
+	28, // 41: gscheduler.TaskManager.SecretDelete:input_type -> gscheduler.SecretName
+	4,  // 42: gscheduler.TaskManager.SecretList:input_type -> gscheduler.Empty
+	29, // 43: gscheduler.TaskManager.CalendarSet:input_type -> gscheduler.Calendar
+	32, // 44: gscheduler.TaskManager.CalendarDelete:input_type -> gscheduler.CalendarName
+	4,  // 45: gscheduler.TaskManager.CalendarList:input_type -> gscheduler.Empty
+	2,  // 46: gscheduler.TaskManager.AppsList:output_type -> gscheduler.List
+	11, // 47: gscheduler.TaskManager.TaskCreate:output_type -> gscheduler.Status
+	11, // 48: gscheduler.TaskManager.TaskUpdate:output_type -> gscheduler.Status
+	11, // 49: gscheduler.TaskManager.TaskDelete:output_type -> gscheduler.Status
+	11, // 50: gscheduler.TaskManager.TaskStop:output_type -> gscheduler.Status
+	11, // 51: gscheduler.TaskManager.TaskStart:output_type -> gscheduler.Status
+	11, // 52: gscheduler.TaskManager.TaskRun:output_type -> gscheduler.Status
+	9,  // 53: gscheduler.TaskManager.TasksList:output_type -> gscheduler.Tasks
+	11, // 54: gscheduler.TaskManager.SchedulerStop:output_type -> gscheduler.Status
+	11, // 55: gscheduler.TaskManager.SchedulerStart:output_type -> gscheduler.Status
+	11, // 56: gscheduler.TaskManager.SchedulerPause:output_type -> gscheduler.Status
+	11, // 57: gscheduler.TaskManager.SchedulerResume:output_type -> gscheduler.Status
+	26, // 58: gscheduler.TaskManager.SchedulerDrain:output_type -> gscheduler.SchedulerState
+	26, // 59: gscheduler.TaskManager.SchedulerStatus:output_type -> gscheduler.SchedulerState
+	14, // 60: gscheduler.TaskManager.SchedulerWatch:output_type -> gscheduler.TaskLog
+	23, // 61: gscheduler.TaskManager.SchedulerRunningTasks:output_type -> gscheduler.RunningTasks
+	12, // 62: gscheduler.TaskManager.ExecCmd:output_type -> gscheduler.ExecStatus
+	2,  // 63: gscheduler.TaskManager.LogList:output_type -> gscheduler.List
+	3,  // 64: gscheduler.TaskManager.LogGet:output_type -> gscheduler.File
+	16, // 65: gscheduler.TaskManager.RunList:output_type -> gscheduler.Runs
+	15, // 66: gscheduler.TaskManager.RunGet:output_type -> gscheduler.Run
+	25, // 67: gscheduler.TaskManager.ScheduleNextRuns:output_type -> gscheduler.ScheduleRuns
+	21, // 68: gscheduler.TaskManager.QueueList:output_type -> gscheduler.Queue
+	11, // 69: gscheduler.TaskManager.QueueCancel:output_type -> gscheduler.Status
+	11, // 70: gscheduler.TaskManager.SecretSet:output_type -> gscheduler.Status
+	11, // 71: gscheduler.TaskManager.SecretDelete:output_type -> gscheduler.Status
+	2,  // 72: gscheduler.TaskManager.SecretList:output_type -> gscheduler.List
+	11, // 73: gscheduler.TaskManager.CalendarSet:output_type -> gscheduler.Status
+	11, // 74: gscheduler.TaskManager.CalendarDelete:output_type -> gscheduler.Status
+	31, // 75: gscheduler.TaskManager.CalendarList:output_type -> gscheduler.Calendars
+	46, // [46:76] is the sub-list for method output_type
+	16, // [16:46] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_gs_proto_init() }
@@ -959,7 +3050,7 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tasks); i {
+			switch v := v.(*Dependency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -971,7 +3062,7 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskUUID); i {
+			switch v := v.(*ResourceLimits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -983,7 +3074,7 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*RetryPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -995,7 +3086,7 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStatus); i {
+			switch v := v.(*Tasks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1007,7 +3098,7 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskLog); i {
+			switch v := v.(*TaskUUID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1019,6 +3110,102 @@ func file_gs_proto_init() {
 			}
 		}
 		file_gs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Run); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Runs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Stop); i {
 			case 0:
 				return &v.state
@@ -1030,19 +3217,176 @@ func file_gs_proto_init() {
 				return nil
 			}
 		}
+		file_gs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Queue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunningTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunningTasks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleRuns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Calendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Calendars); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gs_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_gs_proto_goTypes,
 		DependencyIndexes: file_gs_proto_depIdxs,
+		EnumInfos:         file_gs_proto_enumTypes,
 		MessageInfos:      file_gs_proto_msgTypes,
 	}.Build()
 	File_gs_proto = out.File
@@ -32,11 +32,26 @@ type TaskManagerClient interface {
 	TasksList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Tasks, error)
 	SchedulerStop(ctx context.Context, in *Stop, opts ...grpc.CallOption) (*Status, error)
 	SchedulerStart(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Status, error)
+	SchedulerPause(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Status, error)
+	SchedulerResume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Status, error)
+	SchedulerDrain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerState, error)
+	SchedulerStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerState, error)
 	SchedulerWatch(ctx context.Context, in *Empty, opts ...grpc.CallOption) (TaskManager_SchedulerWatchClient, error)
-	SchedulerRunningTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*List, error)
+	SchedulerRunningTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunningTasks, error)
 	ExecCmd(ctx context.Context, in *Task, opts ...grpc.CallOption) (*ExecStatus, error)
 	LogList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*List, error)
 	LogGet(ctx context.Context, in *Request, opts ...grpc.CallOption) (*File, error)
+	RunList(ctx context.Context, in *RunFilter, opts ...grpc.CallOption) (*Runs, error)
+	RunGet(ctx context.Context, in *RunID, opts ...grpc.CallOption) (*Run, error)
+	ScheduleNextRuns(ctx context.Context, in *ScheduleRequest, opts ...grpc.CallOption) (*ScheduleRuns, error)
+	QueueList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Queue, error)
+	QueueCancel(ctx context.Context, in *RunID, opts ...grpc.CallOption) (*Status, error)
+	SecretSet(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*Status, error)
+	SecretDelete(ctx context.Context, in *SecretName, opts ...grpc.CallOption) (*Status, error)
+	SecretList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*List, error)
+	CalendarSet(ctx context.Context, in *Calendar, opts ...grpc.CallOption) (*Status, error)
+	CalendarDelete(ctx context.Context, in *CalendarName, opts ...grpc.CallOption) (*Status, error)
+	CalendarList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Calendars, error)
 }
 
 type taskManagerClient struct {
@@ -137,6 +152,42 @@ func (c *taskManagerClient) SchedulerStart(ctx context.Context, in *Empty, opts
 	return out, nil
 }
 
+func (c *taskManagerClient) SchedulerPause(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SchedulerPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SchedulerResume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SchedulerResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SchedulerDrain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerState, error) {
+	out := new(SchedulerState)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SchedulerDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SchedulerStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulerState, error) {
+	out := new(SchedulerState)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SchedulerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskManagerClient) SchedulerWatch(ctx context.Context, in *Empty, opts ...grpc.CallOption) (TaskManager_SchedulerWatchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &TaskManager_ServiceDesc.Streams[0], "/gscheduler.TaskManager/SchedulerWatch", opts...)
 	if err != nil {
@@ -169,8 +220,8 @@ func (x *taskManagerSchedulerWatchClient) Recv() (*TaskLog, error) {
 	return m, nil
 }
 
-func (c *taskManagerClient) SchedulerRunningTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*List, error) {
-	out := new(List)
+func (c *taskManagerClient) SchedulerRunningTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunningTasks, error) {
+	out := new(RunningTasks)
 	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SchedulerRunningTasks", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -205,6 +256,105 @@ func (c *taskManagerClient) LogGet(ctx context.Context, in *Request, opts ...grp
 	return out, nil
 }
 
+func (c *taskManagerClient) RunList(ctx context.Context, in *RunFilter, opts ...grpc.CallOption) (*Runs, error) {
+	out := new(Runs)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/RunList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) RunGet(ctx context.Context, in *RunID, opts ...grpc.CallOption) (*Run, error) {
+	out := new(Run)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/RunGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) ScheduleNextRuns(ctx context.Context, in *ScheduleRequest, opts ...grpc.CallOption) (*ScheduleRuns, error) {
+	out := new(ScheduleRuns)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/ScheduleNextRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) QueueList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Queue, error) {
+	out := new(Queue)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/QueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) QueueCancel(ctx context.Context, in *RunID, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/QueueCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SecretSet(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SecretSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SecretDelete(ctx context.Context, in *SecretName, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SecretDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) SecretList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/SecretList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) CalendarSet(ctx context.Context, in *Calendar, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/CalendarSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) CalendarDelete(ctx context.Context, in *CalendarName, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/CalendarDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerClient) CalendarList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Calendars, error) {
+	out := new(Calendars)
+	err := c.cc.Invoke(ctx, "/gscheduler.TaskManager/CalendarList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskManagerServer is the server API for TaskManager service.
 // All implementations must embed UnimplementedTaskManagerServer
 // for forward compatibility
@@ -219,11 +369,26 @@ type TaskManagerServer interface {
 	TasksList(context.Context, *Empty) (*Tasks, error)
 	SchedulerStop(context.Context, *Stop) (*Status, error)
 	SchedulerStart(context.Context, *Empty) (*Status, error)
+	SchedulerPause(context.Context, *Empty) (*Status, error)
+	SchedulerResume(context.Context, *Empty) (*Status, error)
+	SchedulerDrain(context.Context, *Empty) (*SchedulerState, error)
+	SchedulerStatus(context.Context, *Empty) (*SchedulerState, error)
 	SchedulerWatch(*Empty, TaskManager_SchedulerWatchServer) error
-	SchedulerRunningTasks(context.Context, *Empty) (*List, error)
+	SchedulerRunningTasks(context.Context, *Empty) (*RunningTasks, error)
 	ExecCmd(context.Context, *Task) (*ExecStatus, error)
 	LogList(context.Context, *Empty) (*List, error)
 	LogGet(context.Context, *Request) (*File, error)
+	RunList(context.Context, *RunFilter) (*Runs, error)
+	RunGet(context.Context, *RunID) (*Run, error)
+	ScheduleNextRuns(context.Context, *ScheduleRequest) (*ScheduleRuns, error)
+	QueueList(context.Context, *Empty) (*Queue, error)
+	QueueCancel(context.Context, *RunID) (*Status, error)
+	SecretSet(context.Context, *Secret) (*Status, error)
+	SecretDelete(context.Context, *SecretName) (*Status, error)
+	SecretList(context.Context, *Empty) (*List, error)
+	CalendarSet(context.Context, *Calendar) (*Status, error)
+	CalendarDelete(context.Context, *CalendarName) (*Status, error)
+	CalendarList(context.Context, *Empty) (*Calendars, error)
 	mustEmbedUnimplementedTaskManagerServer()
 }
 
@@ -261,10 +426,22 @@ func (UnimplementedTaskManagerServer) SchedulerStop(context.Context, *Stop) (*St
 func (UnimplementedTaskManagerServer) SchedulerStart(context.Context, *Empty) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SchedulerStart not implemented")
 }
+func (UnimplementedTaskManagerServer) SchedulerPause(context.Context, *Empty) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerPause not implemented")
+}
+func (UnimplementedTaskManagerServer) SchedulerResume(context.Context, *Empty) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerResume not implemented")
+}
+func (UnimplementedTaskManagerServer) SchedulerDrain(context.Context, *Empty) (*SchedulerState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerDrain not implemented")
+}
+func (UnimplementedTaskManagerServer) SchedulerStatus(context.Context, *Empty) (*SchedulerState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerStatus not implemented")
+}
 func (UnimplementedTaskManagerServer) SchedulerWatch(*Empty, TaskManager_SchedulerWatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method SchedulerWatch not implemented")
 }
-func (UnimplementedTaskManagerServer) SchedulerRunningTasks(context.Context, *Empty) (*List, error) {
+func (UnimplementedTaskManagerServer) SchedulerRunningTasks(context.Context, *Empty) (*RunningTasks, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SchedulerRunningTasks not implemented")
 }
 func (UnimplementedTaskManagerServer) ExecCmd(context.Context, *Task) (*ExecStatus, error) {
@@ -276,6 +453,39 @@ func (UnimplementedTaskManagerServer) LogList(context.Context, *Empty) (*List, e
 func (UnimplementedTaskManagerServer) LogGet(context.Context, *Request) (*File, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogGet not implemented")
 }
+func (UnimplementedTaskManagerServer) RunList(context.Context, *RunFilter) (*Runs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunList not implemented")
+}
+func (UnimplementedTaskManagerServer) RunGet(context.Context, *RunID) (*Run, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGet not implemented")
+}
+func (UnimplementedTaskManagerServer) ScheduleNextRuns(context.Context, *ScheduleRequest) (*ScheduleRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleNextRuns not implemented")
+}
+func (UnimplementedTaskManagerServer) QueueList(context.Context, *Empty) (*Queue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueList not implemented")
+}
+func (UnimplementedTaskManagerServer) QueueCancel(context.Context, *RunID) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueCancel not implemented")
+}
+func (UnimplementedTaskManagerServer) SecretSet(context.Context, *Secret) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretSet not implemented")
+}
+func (UnimplementedTaskManagerServer) SecretDelete(context.Context, *SecretName) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretDelete not implemented")
+}
+func (UnimplementedTaskManagerServer) SecretList(context.Context, *Empty) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretList not implemented")
+}
+func (UnimplementedTaskManagerServer) CalendarSet(context.Context, *Calendar) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarSet not implemented")
+}
+func (UnimplementedTaskManagerServer) CalendarDelete(context.Context, *CalendarName) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarDelete not implemented")
+}
+func (UnimplementedTaskManagerServer) CalendarList(context.Context, *Empty) (*Calendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarList not implemented")
+}
 func (UnimplementedTaskManagerServer) mustEmbedUnimplementedTaskManagerServer() {}
 
 // UnsafeTaskManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -469,6 +679,78 @@ func _TaskManager_SchedulerStart_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskManager_SchedulerPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SchedulerPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SchedulerPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SchedulerPause(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SchedulerResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SchedulerResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SchedulerResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SchedulerResume(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SchedulerDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SchedulerDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SchedulerDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SchedulerDrain(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SchedulerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SchedulerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SchedulerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SchedulerStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskManager_SchedulerWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -562,6 +844,204 @@ func _TaskManager_LogGet_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskManager_RunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).RunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/RunList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).RunList(ctx, req.(*RunFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_RunGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).RunGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/RunGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).RunGet(ctx, req.(*RunID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_ScheduleNextRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).ScheduleNextRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/ScheduleNextRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).ScheduleNextRuns(ctx, req.(*ScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_QueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).QueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/QueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).QueueList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_QueueCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).QueueCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/QueueCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).QueueCancel(ctx, req.(*RunID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SecretSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secret)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SecretSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SecretSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SecretSet(ctx, req.(*Secret))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SecretDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SecretDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SecretDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SecretDelete(ctx, req.(*SecretName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_SecretList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).SecretList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/SecretList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).SecretList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_CalendarSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Calendar)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).CalendarSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/CalendarSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).CalendarSet(ctx, req.(*Calendar))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_CalendarDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).CalendarDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/CalendarDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).CalendarDelete(ctx, req.(*CalendarName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManager_CalendarList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServer).CalendarList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gscheduler.TaskManager/CalendarList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServer).CalendarList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskManager_ServiceDesc is the grpc.ServiceDesc for TaskManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +1089,22 @@ var TaskManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SchedulerStart",
 			Handler:    _TaskManager_SchedulerStart_Handler,
 		},
+		{
+			MethodName: "SchedulerPause",
+			Handler:    _TaskManager_SchedulerPause_Handler,
+		},
+		{
+			MethodName: "SchedulerResume",
+			Handler:    _TaskManager_SchedulerResume_Handler,
+		},
+		{
+			MethodName: "SchedulerDrain",
+			Handler:    _TaskManager_SchedulerDrain_Handler,
+		},
+		{
+			MethodName: "SchedulerStatus",
+			Handler:    _TaskManager_SchedulerStatus_Handler,
+		},
 		{
 			MethodName: "SchedulerRunningTasks",
 			Handler:    _TaskManager_SchedulerRunningTasks_Handler,
@@ -625,6 +1121,50 @@ var TaskManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LogGet",
 			Handler:    _TaskManager_LogGet_Handler,
 		},
+		{
+			MethodName: "RunList",
+			Handler:    _TaskManager_RunList_Handler,
+		},
+		{
+			MethodName: "RunGet",
+			Handler:    _TaskManager_RunGet_Handler,
+		},
+		{
+			MethodName: "ScheduleNextRuns",
+			Handler:    _TaskManager_ScheduleNextRuns_Handler,
+		},
+		{
+			MethodName: "QueueList",
+			Handler:    _TaskManager_QueueList_Handler,
+		},
+		{
+			MethodName: "QueueCancel",
+			Handler:    _TaskManager_QueueCancel_Handler,
+		},
+		{
+			MethodName: "SecretSet",
+			Handler:    _TaskManager_SecretSet_Handler,
+		},
+		{
+			MethodName: "SecretDelete",
+			Handler:    _TaskManager_SecretDelete_Handler,
+		},
+		{
+			MethodName: "SecretList",
+			Handler:    _TaskManager_SecretList_Handler,
+		},
+		{
+			MethodName: "CalendarSet",
+			Handler:    _TaskManager_CalendarSet_Handler,
+		},
+		{
+			MethodName: "CalendarDelete",
+			Handler:    _TaskManager_CalendarDelete_Handler,
+		},
+		{
+			MethodName: "CalendarList",
+			Handler:    _TaskManager_CalendarList_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
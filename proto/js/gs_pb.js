@@ -15,11 +15,33 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = (function() { return this || window || global || self || Function('return this')(); }).call(null);
 
+goog.exportSymbol('proto.gscheduler.Calendar', null, global);
+goog.exportSymbol('proto.gscheduler.CalendarName', null, global);
+goog.exportSymbol('proto.gscheduler.CalendarWindow', null, global);
+goog.exportSymbol('proto.gscheduler.Calendars', null, global);
+goog.exportSymbol('proto.gscheduler.Dependency', null, global);
 goog.exportSymbol('proto.gscheduler.Empty', null, global);
 goog.exportSymbol('proto.gscheduler.ExecStatus', null, global);
 goog.exportSymbol('proto.gscheduler.File', null, global);
 goog.exportSymbol('proto.gscheduler.List', null, global);
+goog.exportSymbol('proto.gscheduler.Queue', null, global);
+goog.exportSymbol('proto.gscheduler.QueuedRun', null, global);
 goog.exportSymbol('proto.gscheduler.Request', null, global);
+goog.exportSymbol('proto.gscheduler.ResourceLimits', null, global);
+goog.exportSymbol('proto.gscheduler.RetryPolicy', null, global);
+goog.exportSymbol('proto.gscheduler.Run', null, global);
+goog.exportSymbol('proto.gscheduler.RunFilter', null, global);
+goog.exportSymbol('proto.gscheduler.RunID', null, global);
+goog.exportSymbol('proto.gscheduler.RunReason', null, global);
+goog.exportSymbol('proto.gscheduler.RunResult', null, global);
+goog.exportSymbol('proto.gscheduler.RunningTask', null, global);
+goog.exportSymbol('proto.gscheduler.RunningTasks', null, global);
+goog.exportSymbol('proto.gscheduler.Runs', null, global);
+goog.exportSymbol('proto.gscheduler.ScheduleRequest', null, global);
+goog.exportSymbol('proto.gscheduler.ScheduleRuns', null, global);
+goog.exportSymbol('proto.gscheduler.SchedulerState', null, global);
+goog.exportSymbol('proto.gscheduler.Secret', null, global);
+goog.exportSymbol('proto.gscheduler.SecretName', null, global);
 goog.exportSymbol('proto.gscheduler.Status', null, global);
 goog.exportSymbol('proto.gscheduler.Stop', null, global);
 goog.exportSymbol('proto.gscheduler.Task', null, global);
@@ -131,6 +153,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.gscheduler.Task.displayName = 'proto.gscheduler.Task';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.Dependency = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.Dependency, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Dependency.displayName = 'proto.gscheduler.Dependency';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.ResourceLimits = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.ResourceLimits, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.ResourceLimits.displayName = 'proto.gscheduler.ResourceLimits';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.RetryPolicy = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.RetryPolicy.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.RetryPolicy, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RetryPolicy.displayName = 'proto.gscheduler.RetryPolicy';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -215,6 +300,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.gscheduler.ExecStatus.displayName = 'proto.gscheduler.ExecStatus';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.RunResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.RunResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RunResult.displayName = 'proto.gscheduler.RunResult';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -246,155 +352,6134 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.gscheduler.Stop = function(opt_data) {
+proto.gscheduler.Run = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.gscheduler.Stop, jspb.Message);
+goog.inherits(proto.gscheduler.Run, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.gscheduler.Stop.displayName = 'proto.gscheduler.Stop';
+  proto.gscheduler.Run.displayName = 'proto.gscheduler.Run';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Request.toObject(opt_includeInstance, this);
+proto.gscheduler.Runs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.Runs.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.gscheduler.Runs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Runs.displayName = 'proto.gscheduler.Runs';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Request} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    msg: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.gscheduler.RunFilter = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.gscheduler.RunFilter, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RunFilter.displayName = 'proto.gscheduler.RunFilter';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Request}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Request;
-  return proto.gscheduler.Request.deserializeBinaryFromReader(msg, reader);
+proto.gscheduler.RunID = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.gscheduler.RunID, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RunID.displayName = 'proto.gscheduler.RunID';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.gscheduler.Request} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Request}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMsg(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.gscheduler.Stop = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.gscheduler.Stop, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Stop.displayName = 'proto.gscheduler.Stop';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Request.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.gscheduler.QueuedRun = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.gscheduler.QueuedRun, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.QueuedRun.displayName = 'proto.gscheduler.QueuedRun';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Request} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.gscheduler.Request.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getMsg();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.gscheduler.Queue = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.Queue.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.Queue, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Queue.displayName = 'proto.gscheduler.Queue';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.RunningTask = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.RunningTask, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RunningTask.displayName = 'proto.gscheduler.RunningTask';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.RunningTasks = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.RunningTasks.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.RunningTasks, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.RunningTasks.displayName = 'proto.gscheduler.RunningTasks';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.ScheduleRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.ScheduleRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.ScheduleRequest.displayName = 'proto.gscheduler.ScheduleRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.ScheduleRuns = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.ScheduleRuns.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.ScheduleRuns, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.ScheduleRuns.displayName = 'proto.gscheduler.ScheduleRuns';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.SchedulerState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.SchedulerState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.SchedulerState.displayName = 'proto.gscheduler.SchedulerState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.Secret = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.Secret, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Secret.displayName = 'proto.gscheduler.Secret';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.SecretName = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.SecretName, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.SecretName.displayName = 'proto.gscheduler.SecretName';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.Calendar = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.Calendar.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.Calendar, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Calendar.displayName = 'proto.gscheduler.Calendar';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.CalendarWindow = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.CalendarWindow, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.CalendarWindow.displayName = 'proto.gscheduler.CalendarWindow';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.Calendars = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.gscheduler.Calendars.repeatedFields_, null);
+};
+goog.inherits(proto.gscheduler.Calendars, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.Calendars.displayName = 'proto.gscheduler.Calendars';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.gscheduler.CalendarName = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.gscheduler.CalendarName, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.gscheduler.CalendarName.displayName = 'proto.gscheduler.CalendarName';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Request.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Request.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Request} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Request.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    msg: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Request}
+ */
+proto.gscheduler.Request.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Request;
+  return proto.gscheduler.Request.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Request} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Request}
+ */
+proto.gscheduler.Request.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMsg(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Request.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Request.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Request} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Request.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMsg();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string msg = 1;
+ * @return {string}
+ */
+proto.gscheduler.Request.prototype.getMsg = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Request} returns this
+ */
+proto.gscheduler.Request.prototype.setMsg = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.List.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.List.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.List.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.List} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.List.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    dataList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.List}
+ */
+proto.gscheduler.List.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.List;
+  return proto.gscheduler.List.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.List} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.List}
+ */
+proto.gscheduler.List.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.List.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.List.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.List} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.List.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDataList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string data = 1;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.List.prototype.getDataList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.List} returns this
+ */
+proto.gscheduler.List.prototype.setDataList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.List} returns this
+ */
+proto.gscheduler.List.prototype.addData = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.List} returns this
+ */
+proto.gscheduler.List.prototype.clearDataList = function() {
+  return this.setDataList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.File.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.File.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.File} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.File.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    content: msg.getContent_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.File}
+ */
+proto.gscheduler.File.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.File;
+  return proto.gscheduler.File.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.File} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.File}
+ */
+proto.gscheduler.File.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.File.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.File.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.File} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.File.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes content = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.gscheduler.File.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.gscheduler.File.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.File.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.gscheduler.File} returns this
+ */
+proto.gscheduler.File.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Empty.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Empty.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Empty} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Empty.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Empty}
+ */
+proto.gscheduler.Empty.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Empty;
+  return proto.gscheduler.Empty.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Empty} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Empty}
+ */
+proto.gscheduler.Empty.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Empty.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Empty.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Empty} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Empty.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.Task.repeatedFields_ = [8,14,17,28,29];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Task.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Task.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Task} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Task.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    tagsMap: (f = msg.getTagsMap()) ? f.toObject(includeInstance, undefined) : [],
+    schedule: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    timeout: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    app: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    workDir: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    argsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    nextTask: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    cronId: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    enabled: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
+    retry: (f = msg.getRetry()) && proto.gscheduler.RetryPolicy.toObject(includeInstance, f),
+    dependsOnList: jspb.Message.toObjectList(msg.getDependsOnList(),
+    proto.gscheduler.Dependency.toObject, includeInstance),
+    onFailureTask: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    onFinishTask: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    alertEmailsList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
+    envMap: (f = msg.getEnvMap()) ? f.toObject(includeInstance, undefined) : [],
+    concurrencyPolicy: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    concurrencyLimit: jspb.Message.getFieldWithDefault(msg, 20, 0),
+    priority: jspb.Message.getFieldWithDefault(msg, 21, 0),
+    misfirePolicy: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    misfireLimit: jspb.Message.getFieldWithDefault(msg, 23, 0),
+    misfireGrace: jspb.Message.getFieldWithDefault(msg, 24, 0),
+    timezone: jspb.Message.getFieldWithDefault(msg, 25, ""),
+    nextRun: jspb.Message.getFieldWithDefault(msg, 26, 0),
+    prevRun: jspb.Message.getFieldWithDefault(msg, 27, 0),
+    excludeCalendarsList: (f = jspb.Message.getRepeatedField(msg, 28)) == null ? undefined : f,
+    includeCalendarsList: (f = jspb.Message.getRepeatedField(msg, 29)) == null ? undefined : f,
+    runAt: jspb.Message.getFieldWithDefault(msg, 30, ""),
+    startAfter: jspb.Message.getFieldWithDefault(msg, 31, ""),
+    endBefore: jspb.Message.getFieldWithDefault(msg, 32, ""),
+    expireAction: jspb.Message.getFieldWithDefault(msg, 33, ""),
+    jitter: jspb.Message.getFieldWithDefault(msg, 34, 0),
+    stopSignal: jspb.Message.getFieldWithDefault(msg, 35, ""),
+    killGracePeriod: jspb.Message.getFieldWithDefault(msg, 36, 0),
+    limits: (f = msg.getLimits()) && proto.gscheduler.ResourceLimits.toObject(includeInstance, f),
+    runAsUser: jspb.Message.getFieldWithDefault(msg, 38, ""),
+    runAsGroup: jspb.Message.getFieldWithDefault(msg, 39, ""),
+    stdin: jspb.Message.getFieldWithDefault(msg, 40, ""),
+    template: jspb.Message.getBooleanFieldWithDefault(msg, 41, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Task}
+ */
+proto.gscheduler.Task.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Task;
+  return proto.gscheduler.Task.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Task} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Task}
+ */
+proto.gscheduler.Task.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 3:
+      var value = msg.getTagsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSchedule(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimeout(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApp(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkDir(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addArgs(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextTask(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCronId(value);
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setEnabled(value);
+      break;
+    case 13:
+      var value = new proto.gscheduler.RetryPolicy;
+      reader.readMessage(value,proto.gscheduler.RetryPolicy.deserializeBinaryFromReader);
+      msg.setRetry(value);
+      break;
+    case 14:
+      var value = new proto.gscheduler.Dependency;
+      reader.readMessage(value,proto.gscheduler.Dependency.deserializeBinaryFromReader);
+      msg.addDependsOn(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOnFailureTask(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOnFinishTask(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAlertEmails(value);
+      break;
+    case 18:
+      var value = msg.getEnvMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConcurrencyPolicy(value);
+      break;
+    case 20:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setConcurrencyLimit(value);
+      break;
+    case 21:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPriority(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMisfirePolicy(value);
+      break;
+    case 23:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMisfireLimit(value);
+      break;
+    case 24:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMisfireGrace(value);
+      break;
+    case 25:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimezone(value);
+      break;
+    case 26:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNextRun(value);
+      break;
+    case 27:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPrevRun(value);
+      break;
+    case 28:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addExcludeCalendars(value);
+      break;
+    case 29:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIncludeCalendars(value);
+      break;
+    case 30:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunAt(value);
+      break;
+    case 31:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStartAfter(value);
+      break;
+    case 32:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEndBefore(value);
+      break;
+    case 33:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExpireAction(value);
+      break;
+    case 34:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setJitter(value);
+      break;
+    case 35:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStopSignal(value);
+      break;
+    case 36:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setKillGracePeriod(value);
+      break;
+    case 37:
+      var value = new proto.gscheduler.ResourceLimits;
+      reader.readMessage(value,proto.gscheduler.ResourceLimits.deserializeBinaryFromReader);
+      msg.setLimits(value);
+      break;
+    case 38:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunAsUser(value);
+      break;
+    case 39:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunAsGroup(value);
+      break;
+    case 40:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStdin(value);
+      break;
+    case 41:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTemplate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Task.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Task.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Task} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Task.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTagsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getSchedule();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getApp();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getWorkDir();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getNextTask();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getCronId();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getEnabled();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = message.getRetry();
+  if (f != null) {
+    writer.writeMessage(
+      13,
+      f,
+      proto.gscheduler.RetryPolicy.serializeBinaryToWriter
+    );
+  }
+  f = message.getDependsOnList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.gscheduler.Dependency.serializeBinaryToWriter
+    );
+  }
+  f = message.getOnFailureTask();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getOnFinishTask();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = message.getAlertEmailsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      17,
+      f
+    );
+  }
+  f = message.getEnvMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(18, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getConcurrencyPolicy();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getConcurrencyLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      20,
+      f
+    );
+  }
+  f = message.getPriority();
+  if (f !== 0) {
+    writer.writeInt64(
+      21,
+      f
+    );
+  }
+  f = message.getMisfirePolicy();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+  f = message.getMisfireLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      23,
+      f
+    );
+  }
+  f = message.getMisfireGrace();
+  if (f !== 0) {
+    writer.writeInt64(
+      24,
+      f
+    );
+  }
+  f = message.getTimezone();
+  if (f.length > 0) {
+    writer.writeString(
+      25,
+      f
+    );
+  }
+  f = message.getNextRun();
+  if (f !== 0) {
+    writer.writeInt64(
+      26,
+      f
+    );
+  }
+  f = message.getPrevRun();
+  if (f !== 0) {
+    writer.writeInt64(
+      27,
+      f
+    );
+  }
+  f = message.getExcludeCalendarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      28,
+      f
+    );
+  }
+  f = message.getIncludeCalendarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      29,
+      f
+    );
+  }
+  f = message.getRunAt();
+  if (f.length > 0) {
+    writer.writeString(
+      30,
+      f
+    );
+  }
+  f = message.getStartAfter();
+  if (f.length > 0) {
+    writer.writeString(
+      31,
+      f
+    );
+  }
+  f = message.getEndBefore();
+  if (f.length > 0) {
+    writer.writeString(
+      32,
+      f
+    );
+  }
+  f = message.getExpireAction();
+  if (f.length > 0) {
+    writer.writeString(
+      33,
+      f
+    );
+  }
+  f = message.getJitter();
+  if (f !== 0) {
+    writer.writeInt64(
+      34,
+      f
+    );
+  }
+  f = message.getStopSignal();
+  if (f.length > 0) {
+    writer.writeString(
+      35,
+      f
+    );
+  }
+  f = message.getKillGracePeriod();
+  if (f !== 0) {
+    writer.writeInt64(
+      36,
+      f
+    );
+  }
+  f = message.getLimits();
+  if (f != null) {
+    writer.writeMessage(
+      37,
+      f,
+      proto.gscheduler.ResourceLimits.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunAsUser();
+  if (f.length > 0) {
+    writer.writeString(
+      38,
+      f
+    );
+  }
+  f = message.getRunAsGroup();
+  if (f.length > 0) {
+    writer.writeString(
+      39,
+      f
+    );
+  }
+  f = message.getStdin();
+  if (f.length > 0) {
+    writer.writeString(
+      40,
+      f
+    );
+  }
+  f = message.getTemplate();
+  if (f) {
+    writer.writeBool(
+      41,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string description = 2;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, string> tags = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.gscheduler.Task.prototype.getTagsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearTagsMap = function() {
+  this.getTagsMap().clear();
+  return this;};
+
+
+/**
+ * optional string schedule = 4;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getSchedule = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setSchedule = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 timeout = 5;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string app = 6;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getApp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setApp = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string work_dir = 7;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getWorkDir = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setWorkDir = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated string args = 8;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.Task.prototype.getArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setArgsList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.addArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearArgsList = function() {
+  return this.setArgsList([]);
+};
+
+
+/**
+ * optional string next_task = 9;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getNextTask = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setNextTask = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string uuid = 10;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional int64 cron_id = 11;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getCronId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setCronId = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional bool enabled = 12;
+ * @return {boolean}
+ */
+proto.gscheduler.Task.prototype.getEnabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setEnabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
+};
+
+
+/**
+ * optional RetryPolicy retry = 13;
+ * @return {?proto.gscheduler.RetryPolicy}
+ */
+proto.gscheduler.Task.prototype.getRetry = function() {
+  return /** @type{?proto.gscheduler.RetryPolicy} */ (
+    jspb.Message.getWrapperField(this, proto.gscheduler.RetryPolicy, 13));
+};
+
+
+/**
+ * @param {?proto.gscheduler.RetryPolicy|undefined} value
+ * @return {!proto.gscheduler.Task} returns this
+*/
+proto.gscheduler.Task.prototype.setRetry = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearRetry = function() {
+  return this.setRetry(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.gscheduler.Task.prototype.hasRetry = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * repeated Dependency depends_on = 14;
+ * @return {!Array<!proto.gscheduler.Dependency>}
+ */
+proto.gscheduler.Task.prototype.getDependsOnList = function() {
+  return /** @type{!Array<!proto.gscheduler.Dependency>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.Dependency, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.gscheduler.Dependency>} value
+ * @return {!proto.gscheduler.Task} returns this
+*/
+proto.gscheduler.Task.prototype.setDependsOnList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.gscheduler.Dependency=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Dependency}
+ */
+proto.gscheduler.Task.prototype.addDependsOn = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.gscheduler.Dependency, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearDependsOnList = function() {
+  return this.setDependsOnList([]);
+};
+
+
+/**
+ * optional string on_failure_task = 15;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getOnFailureTask = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setOnFailureTask = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional string on_finish_task = 16;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getOnFinishTask = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setOnFinishTask = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+/**
+ * repeated string alert_emails = 17;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.Task.prototype.getAlertEmailsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 17));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setAlertEmailsList = function(value) {
+  return jspb.Message.setField(this, 17, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.addAlertEmails = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 17, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearAlertEmailsList = function() {
+  return this.setAlertEmailsList([]);
+};
+
+
+/**
+ * map<string, string> env = 18;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.gscheduler.Task.prototype.getEnvMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 18, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearEnvMap = function() {
+  this.getEnvMap().clear();
+  return this;};
+
+
+/**
+ * optional string concurrency_policy = 19;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getConcurrencyPolicy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setConcurrencyPolicy = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional int64 concurrency_limit = 20;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getConcurrencyLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 20, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setConcurrencyLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 20, value);
+};
+
+
+/**
+ * optional int64 priority = 21;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getPriority = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 21, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setPriority = function(value) {
+  return jspb.Message.setProto3IntField(this, 21, value);
+};
+
+
+/**
+ * optional string misfire_policy = 22;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getMisfirePolicy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setMisfirePolicy = function(value) {
+  return jspb.Message.setProto3StringField(this, 22, value);
+};
+
+
+/**
+ * optional int64 misfire_limit = 23;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getMisfireLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 23, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setMisfireLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 23, value);
+};
+
+
+/**
+ * optional int64 misfire_grace = 24;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getMisfireGrace = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 24, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setMisfireGrace = function(value) {
+  return jspb.Message.setProto3IntField(this, 24, value);
+};
+
+
+/**
+ * optional string timezone = 25;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getTimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 25, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setTimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 25, value);
+};
+
+
+/**
+ * optional int64 next_run = 26;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getNextRun = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 26, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setNextRun = function(value) {
+  return jspb.Message.setProto3IntField(this, 26, value);
+};
+
+
+/**
+ * optional int64 prev_run = 27;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getPrevRun = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 27, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setPrevRun = function(value) {
+  return jspb.Message.setProto3IntField(this, 27, value);
+};
+
+
+/**
+ * repeated string exclude_calendars = 28;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.Task.prototype.getExcludeCalendarsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 28));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setExcludeCalendarsList = function(value) {
+  return jspb.Message.setField(this, 28, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.addExcludeCalendars = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 28, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearExcludeCalendarsList = function() {
+  return this.setExcludeCalendarsList([]);
+};
+
+
+/**
+ * repeated string include_calendars = 29;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.Task.prototype.getIncludeCalendarsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 29));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setIncludeCalendarsList = function(value) {
+  return jspb.Message.setField(this, 29, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.addIncludeCalendars = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 29, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearIncludeCalendarsList = function() {
+  return this.setIncludeCalendarsList([]);
+};
+
+
+/**
+ * optional string run_at = 30;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getRunAt = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 30, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setRunAt = function(value) {
+  return jspb.Message.setProto3StringField(this, 30, value);
+};
+
+
+/**
+ * optional string start_after = 31;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getStartAfter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 31, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setStartAfter = function(value) {
+  return jspb.Message.setProto3StringField(this, 31, value);
+};
+
+
+/**
+ * optional string end_before = 32;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getEndBefore = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 32, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setEndBefore = function(value) {
+  return jspb.Message.setProto3StringField(this, 32, value);
+};
+
+
+/**
+ * optional string expire_action = 33;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getExpireAction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 33, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setExpireAction = function(value) {
+  return jspb.Message.setProto3StringField(this, 33, value);
+};
+
+
+/**
+ * optional int64 jitter = 34;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getJitter = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 34, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setJitter = function(value) {
+  return jspb.Message.setProto3IntField(this, 34, value);
+};
+
+
+/**
+ * optional string stop_signal = 35;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getStopSignal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 35, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setStopSignal = function(value) {
+  return jspb.Message.setProto3StringField(this, 35, value);
+};
+
+
+/**
+ * optional int64 kill_grace_period = 36;
+ * @return {number}
+ */
+proto.gscheduler.Task.prototype.getKillGracePeriod = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 36, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setKillGracePeriod = function(value) {
+  return jspb.Message.setProto3IntField(this, 36, value);
+};
+
+
+/**
+ * optional ResourceLimits limits = 37;
+ * @return {?proto.gscheduler.ResourceLimits}
+ */
+proto.gscheduler.Task.prototype.getLimits = function() {
+  return /** @type{?proto.gscheduler.ResourceLimits} */ (
+    jspb.Message.getWrapperField(this, proto.gscheduler.ResourceLimits, 37));
+};
+
+
+/**
+ * @param {?proto.gscheduler.ResourceLimits|undefined} value
+ * @return {!proto.gscheduler.Task} returns this
+*/
+proto.gscheduler.Task.prototype.setLimits = function(value) {
+  return jspb.Message.setWrapperField(this, 37, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.clearLimits = function() {
+  return this.setLimits(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.gscheduler.Task.prototype.hasLimits = function() {
+  return jspb.Message.getField(this, 37) != null;
+};
+
+
+/**
+ * optional string run_as_user = 38;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getRunAsUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 38, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setRunAsUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 38, value);
+};
+
+
+/**
+ * optional string run_as_group = 39;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getRunAsGroup = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 39, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setRunAsGroup = function(value) {
+  return jspb.Message.setProto3StringField(this, 39, value);
+};
+
+
+/**
+ * optional string stdin = 40;
+ * @return {string}
+ */
+proto.gscheduler.Task.prototype.getStdin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 40, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setStdin = function(value) {
+  return jspb.Message.setProto3StringField(this, 40, value);
+};
+
+
+/**
+ * optional bool template = 41;
+ * @return {boolean}
+ */
+proto.gscheduler.Task.prototype.getTemplate = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 41, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.gscheduler.Task} returns this
+ */
+proto.gscheduler.Task.prototype.setTemplate = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 41, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Dependency.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Dependency.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Dependency} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Dependency.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    taskUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    condition: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Dependency}
+ */
+proto.gscheduler.Dependency.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Dependency;
+  return proto.gscheduler.Dependency.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Dependency} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Dependency}
+ */
+proto.gscheduler.Dependency.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTaskUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCondition(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Dependency.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Dependency.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Dependency} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Dependency.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTaskUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCondition();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string task_uuid = 1;
+ * @return {string}
+ */
+proto.gscheduler.Dependency.prototype.getTaskUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Dependency} returns this
+ */
+proto.gscheduler.Dependency.prototype.setTaskUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string condition = 2;
+ * @return {string}
+ */
+proto.gscheduler.Dependency.prototype.getCondition = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Dependency} returns this
+ */
+proto.gscheduler.Dependency.prototype.setCondition = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.ResourceLimits.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.ResourceLimits.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.ResourceLimits} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.ResourceLimits.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    memoryMb: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    cpuPercent: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cpuWeight: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    openFiles: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    processes: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    nice: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.ResourceLimits}
+ */
+proto.gscheduler.ResourceLimits.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.ResourceLimits;
+  return proto.gscheduler.ResourceLimits.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.ResourceLimits} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.ResourceLimits}
+ */
+proto.gscheduler.ResourceLimits.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMemoryMb(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuPercent(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuWeight(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOpenFiles(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setProcesses(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNice(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.ResourceLimits.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.ResourceLimits.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.ResourceLimits} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.ResourceLimits.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMemoryMb();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getCpuPercent();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getCpuWeight();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getOpenFiles();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getProcesses();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getNice();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 memory_mb = 1;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getMemoryMb = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setMemoryMb = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 cpu_percent = 2;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getCpuPercent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setCpuPercent = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 cpu_weight = 3;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getCpuWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setCpuWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 open_files = 4;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getOpenFiles = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setOpenFiles = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 processes = 5;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getProcesses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setProcesses = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 nice = 6;
+ * @return {number}
+ */
+proto.gscheduler.ResourceLimits.prototype.getNice = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ResourceLimits} returns this
+ */
+proto.gscheduler.ResourceLimits.prototype.setNice = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.RetryPolicy.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.RetryPolicy.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RetryPolicy.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.RetryPolicy} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RetryPolicy.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    maxAttempts: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    backoff: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    delay: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    maxDelay: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    jitter: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    exitCodesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    onTimeout: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.RetryPolicy}
+ */
+proto.gscheduler.RetryPolicy.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.RetryPolicy;
+  return proto.gscheduler.RetryPolicy.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.RetryPolicy} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.RetryPolicy}
+ */
+proto.gscheduler.RetryPolicy.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaxAttempts(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBackoff(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDelay(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaxDelay(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setJitter(value);
+      break;
+    case 6:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt64() : [reader.readInt64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExitCodes(values[i]);
+      }
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOnTimeout(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.RetryPolicy.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.RetryPolicy.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.RetryPolicy} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RetryPolicy.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMaxAttempts();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getBackoff();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDelay();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getMaxDelay();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getJitter();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getExitCodesList();
+  if (f.length > 0) {
+    writer.writePackedInt64(
+      6,
+      f
+    );
+  }
+  f = message.getOnTimeout();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 max_attempts = 1;
+ * @return {number}
+ */
+proto.gscheduler.RetryPolicy.prototype.getMaxAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setMaxAttempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string backoff = 2;
+ * @return {string}
+ */
+proto.gscheduler.RetryPolicy.prototype.getBackoff = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setBackoff = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 delay = 3;
+ * @return {number}
+ */
+proto.gscheduler.RetryPolicy.prototype.getDelay = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setDelay = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 max_delay = 4;
+ * @return {number}
+ */
+proto.gscheduler.RetryPolicy.prototype.getMaxDelay = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setMaxDelay = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 jitter = 5;
+ * @return {number}
+ */
+proto.gscheduler.RetryPolicy.prototype.getJitter = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setJitter = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * repeated int64 exit_codes = 6;
+ * @return {!Array<number>}
+ */
+proto.gscheduler.RetryPolicy.prototype.getExitCodesList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setExitCodesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.addExitCodes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.clearExitCodesList = function() {
+  return this.setExitCodesList([]);
+};
+
+
+/**
+ * optional bool on_timeout = 7;
+ * @return {boolean}
+ */
+proto.gscheduler.RetryPolicy.prototype.getOnTimeout = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.gscheduler.RetryPolicy} returns this
+ */
+proto.gscheduler.RetryPolicy.prototype.setOnTimeout = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.Tasks.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Tasks.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Tasks.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Tasks} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Tasks.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tasksList: jspb.Message.toObjectList(msg.getTasksList(),
+    proto.gscheduler.Task.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Tasks}
+ */
+proto.gscheduler.Tasks.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Tasks;
+  return proto.gscheduler.Tasks.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Tasks} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Tasks}
+ */
+proto.gscheduler.Tasks.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.gscheduler.Task;
+      reader.readMessage(value,proto.gscheduler.Task.deserializeBinaryFromReader);
+      msg.addTasks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Tasks.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Tasks.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Tasks} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Tasks.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTasksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.gscheduler.Task.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Task tasks = 1;
+ * @return {!Array<!proto.gscheduler.Task>}
+ */
+proto.gscheduler.Tasks.prototype.getTasksList = function() {
+  return /** @type{!Array<!proto.gscheduler.Task>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.Task, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.gscheduler.Task>} value
+ * @return {!proto.gscheduler.Tasks} returns this
+*/
+proto.gscheduler.Tasks.prototype.setTasksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.gscheduler.Task=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Task}
+ */
+proto.gscheduler.Tasks.prototype.addTasks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.gscheduler.Task, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Tasks} returns this
+ */
+proto.gscheduler.Tasks.prototype.clearTasksList = function() {
+  return this.setTasksList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.TaskUUID.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.TaskUUID.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.TaskUUID} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.TaskUUID.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    force: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.TaskUUID}
+ */
+proto.gscheduler.TaskUUID.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.TaskUUID;
+  return proto.gscheduler.TaskUUID.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.TaskUUID} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.TaskUUID}
+ */
+proto.gscheduler.TaskUUID.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForce(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.TaskUUID.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.TaskUUID.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.TaskUUID} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.TaskUUID.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getForce();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
+ * @return {string}
+ */
+proto.gscheduler.TaskUUID.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskUUID} returns this
+ */
+proto.gscheduler.TaskUUID.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool force = 2;
+ * @return {boolean}
+ */
+proto.gscheduler.TaskUUID.prototype.getForce = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.gscheduler.TaskUUID} returns this
+ */
+proto.gscheduler.TaskUUID.prototype.setForce = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Status.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Status.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Status} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Status.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Status}
+ */
+proto.gscheduler.Status.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Status;
+  return proto.gscheduler.Status.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Status} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Status}
+ */
+proto.gscheduler.Status.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Status.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Status.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Status} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Status.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
+ * @return {string}
+ */
+proto.gscheduler.Status.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Status} returns this
+ */
+proto.gscheduler.Status.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.gscheduler.Status.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Status} returns this
+ */
+proto.gscheduler.Status.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string error = 3;
+ * @return {string}
+ */
+proto.gscheduler.Status.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Status} returns this
+ */
+proto.gscheduler.Status.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.ExecStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.ExecStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.ExecStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.ExecStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    stderr: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stdout: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    result: (f = msg.getResult()) && proto.gscheduler.RunResult.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.ExecStatus}
+ */
+proto.gscheduler.ExecStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.ExecStatus;
+  return proto.gscheduler.ExecStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.ExecStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.ExecStatus}
+ */
+proto.gscheduler.ExecStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStderr(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStdout(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExitCode(value);
+      break;
+    case 4:
+      var value = new proto.gscheduler.RunResult;
+      reader.readMessage(value,proto.gscheduler.RunResult.deserializeBinaryFromReader);
+      msg.setResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.ExecStatus.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.ExecStatus.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.ExecStatus} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.ExecStatus.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStderr();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStdout();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.gscheduler.RunResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string stderr = 1;
+ * @return {string}
+ */
+proto.gscheduler.ExecStatus.prototype.getStderr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.ExecStatus} returns this
+ */
+proto.gscheduler.ExecStatus.prototype.setStderr = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string stdout = 2;
+ * @return {string}
+ */
+proto.gscheduler.ExecStatus.prototype.getStdout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.ExecStatus} returns this
+ */
+proto.gscheduler.ExecStatus.prototype.setStdout = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 exit_code = 3;
+ * @return {number}
+ */
+proto.gscheduler.ExecStatus.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.ExecStatus} returns this
+ */
+proto.gscheduler.ExecStatus.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional RunResult result = 4;
+ * @return {?proto.gscheduler.RunResult}
+ */
+proto.gscheduler.ExecStatus.prototype.getResult = function() {
+  return /** @type{?proto.gscheduler.RunResult} */ (
+    jspb.Message.getWrapperField(this, proto.gscheduler.RunResult, 4));
+};
+
+
+/**
+ * @param {?proto.gscheduler.RunResult|undefined} value
+ * @return {!proto.gscheduler.ExecStatus} returns this
+*/
+proto.gscheduler.ExecStatus.prototype.setResult = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.gscheduler.ExecStatus} returns this
+ */
+proto.gscheduler.ExecStatus.prototype.clearResult = function() {
+  return this.setResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.gscheduler.ExecStatus.prototype.hasResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.RunResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RunResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.RunResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    signal: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.RunResult}
+ */
+proto.gscheduler.RunResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.RunResult;
+  return proto.gscheduler.RunResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.RunResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.RunResult}
+ */
+proto.gscheduler.RunResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExitCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSignal(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDurationMs(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.gscheduler.RunReason} */ (reader.readEnum());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.RunResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.RunResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.RunResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getSignal();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 exit_code = 1;
+ * @return {number}
+ */
+proto.gscheduler.RunResult.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunResult} returns this
+ */
+proto.gscheduler.RunResult.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string signal = 2;
+ * @return {string}
+ */
+proto.gscheduler.RunResult.prototype.getSignal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.RunResult} returns this
+ */
+proto.gscheduler.RunResult.prototype.setSignal = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 duration_ms = 3;
+ * @return {number}
+ */
+proto.gscheduler.RunResult.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunResult} returns this
+ */
+proto.gscheduler.RunResult.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional RunReason reason = 4;
+ * @return {!proto.gscheduler.RunReason}
+ */
+proto.gscheduler.RunResult.prototype.getReason = function() {
+  return /** @type {!proto.gscheduler.RunReason} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.gscheduler.RunReason} value
+ * @return {!proto.gscheduler.RunResult} returns this
+ */
+proto.gscheduler.RunResult.prototype.setReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.TaskLog.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.TaskLog.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.TaskLog} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.TaskLog.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    tagsMap: (f = msg.getTagsMap()) ? f.toObject(includeInstance, undefined) : [],
+    uuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    timestamp: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    runId: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    result: (f = msg.getResult()) && proto.gscheduler.RunResult.toObject(includeInstance, f),
+    attempt: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.TaskLog}
+ */
+proto.gscheduler.TaskLog.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.TaskLog;
+  return proto.gscheduler.TaskLog.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.TaskLog} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.TaskLog}
+ */
+proto.gscheduler.TaskLog.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = msg.getTagsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimestamp(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    case 8:
+      var value = new proto.gscheduler.RunResult;
+      reader.readMessage(value,proto.gscheduler.RunResult.deserializeBinaryFromReader);
+      msg.setResult(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAttempt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.TaskLog.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.TaskLog.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.TaskLog} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.TaskLog.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTagsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getResult();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.gscheduler.RunResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getAttempt();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.gscheduler.TaskLog.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> tags = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.gscheduler.TaskLog.prototype.getTagsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.clearTagsMap = function() {
+  this.getTagsMap().clear();
+  return this;};
+
+
+/**
+ * optional string uuid = 3;
+ * @return {string}
+ */
+proto.gscheduler.TaskLog.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string type = 4;
+ * @return {string}
+ */
+proto.gscheduler.TaskLog.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string message = 5;
+ * @return {string}
+ */
+proto.gscheduler.TaskLog.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int64 timestamp = 6;
+ * @return {number}
+ */
+proto.gscheduler.TaskLog.prototype.getTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setTimestamp = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string run_id = 7;
+ * @return {string}
+ */
+proto.gscheduler.TaskLog.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional RunResult result = 8;
+ * @return {?proto.gscheduler.RunResult}
+ */
+proto.gscheduler.TaskLog.prototype.getResult = function() {
+  return /** @type{?proto.gscheduler.RunResult} */ (
+    jspb.Message.getWrapperField(this, proto.gscheduler.RunResult, 8));
+};
+
+
+/**
+ * @param {?proto.gscheduler.RunResult|undefined} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+*/
+proto.gscheduler.TaskLog.prototype.setResult = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.clearResult = function() {
+  return this.setResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.gscheduler.TaskLog.prototype.hasResult = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional int64 attempt = 9;
+ * @return {number}
+ */
+proto.gscheduler.TaskLog.prototype.getAttempt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.TaskLog} returns this
+ */
+proto.gscheduler.TaskLog.prototype.setAttempt = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Run.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Run.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Run} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Run.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    taskUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    trigger: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    state: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    startTime: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    endTime: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    result: (f = msg.getResult()) && proto.gscheduler.RunResult.toObject(includeInstance, f),
+    attempts: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    workflowId: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    stderrTail: jspb.Message.getFieldWithDefault(msg, 12, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Run}
+ */
+proto.gscheduler.Run.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Run;
+  return proto.gscheduler.Run.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Run} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Run}
+ */
+proto.gscheduler.Run.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTaskUuid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTrigger(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setState(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartTime(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEndTime(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExitCode(value);
+      break;
+    case 9:
+      var value = new proto.gscheduler.RunResult;
+      reader.readMessage(value,proto.gscheduler.RunResult.deserializeBinaryFromReader);
+      msg.setResult(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAttempts(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkflowId(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStderrTail(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Run.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Run.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Run} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Run.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTaskUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTrigger();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getState();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getStartTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getEndTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getResult();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.gscheduler.RunResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getAttempts();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getWorkflowId();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getStderrTail();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string run_id = 1;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string task_uuid = 2;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getTaskUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setTaskUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string trigger = 4;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getTrigger = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setTrigger = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string state = 5;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getState = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setState = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int64 start_time = 6;
+ * @return {number}
+ */
+proto.gscheduler.Run.prototype.getStartTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setStartTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 end_time = 7;
+ * @return {number}
+ */
+proto.gscheduler.Run.prototype.getEndTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setEndTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int64 exit_code = 8;
+ * @return {number}
+ */
+proto.gscheduler.Run.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional RunResult result = 9;
+ * @return {?proto.gscheduler.RunResult}
+ */
+proto.gscheduler.Run.prototype.getResult = function() {
+  return /** @type{?proto.gscheduler.RunResult} */ (
+    jspb.Message.getWrapperField(this, proto.gscheduler.RunResult, 9));
+};
+
+
+/**
+ * @param {?proto.gscheduler.RunResult|undefined} value
+ * @return {!proto.gscheduler.Run} returns this
+*/
+proto.gscheduler.Run.prototype.setResult = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.clearResult = function() {
+  return this.setResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.gscheduler.Run.prototype.hasResult = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional int64 attempts = 10;
+ * @return {number}
+ */
+proto.gscheduler.Run.prototype.getAttempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setAttempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional string workflow_id = 11;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getWorkflowId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setWorkflowId = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string stderr_tail = 12;
+ * @return {string}
+ */
+proto.gscheduler.Run.prototype.getStderrTail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.Run} returns this
+ */
+proto.gscheduler.Run.prototype.setStderrTail = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.Runs.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Runs.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Runs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Runs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Runs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runsList: jspb.Message.toObjectList(msg.getRunsList(),
+    proto.gscheduler.Run.toObject, includeInstance),
+    total: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Runs}
+ */
+proto.gscheduler.Runs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Runs;
+  return proto.gscheduler.Runs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Runs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Runs}
+ */
+proto.gscheduler.Runs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.gscheduler.Run;
+      reader.readMessage(value,proto.gscheduler.Run.deserializeBinaryFromReader);
+      msg.addRuns(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Runs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Runs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Runs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Runs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.gscheduler.Run.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated Run runs = 1;
+ * @return {!Array<!proto.gscheduler.Run>}
+ */
+proto.gscheduler.Runs.prototype.getRunsList = function() {
+  return /** @type{!Array<!proto.gscheduler.Run>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.Run, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.gscheduler.Run>} value
+ * @return {!proto.gscheduler.Runs} returns this
+*/
+proto.gscheduler.Runs.prototype.setRunsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.gscheduler.Run=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Run}
+ */
+proto.gscheduler.Runs.prototype.addRuns = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.gscheduler.Run, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Runs} returns this
+ */
+proto.gscheduler.Runs.prototype.clearRunsList = function() {
+  return this.setRunsList([]);
+};
+
+
+/**
+ * optional int64 total = 2;
+ * @return {number}
+ */
+proto.gscheduler.Runs.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.Runs} returns this
+ */
+proto.gscheduler.Runs.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.RunFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RunFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.RunFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    taskUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    offset: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.RunFilter}
+ */
+proto.gscheduler.RunFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.RunFilter;
+  return proto.gscheduler.RunFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.RunFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.RunFilter}
+ */
+proto.gscheduler.RunFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTaskUuid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOffset(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLimit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.RunFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.RunFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.RunFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTaskUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string task_uuid = 1;
+ * @return {string}
+ */
+proto.gscheduler.RunFilter.prototype.getTaskUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.RunFilter} returns this
+ */
+proto.gscheduler.RunFilter.prototype.setTaskUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 offset = 2;
+ * @return {number}
+ */
+proto.gscheduler.RunFilter.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunFilter} returns this
+ */
+proto.gscheduler.RunFilter.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 limit = 3;
+ * @return {number}
+ */
+proto.gscheduler.RunFilter.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunFilter} returns this
+ */
+proto.gscheduler.RunFilter.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.RunID.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RunID.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.RunID} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunID.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.RunID}
+ */
+proto.gscheduler.RunID.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.RunID;
+  return proto.gscheduler.RunID.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.RunID} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.RunID}
+ */
+proto.gscheduler.RunID.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.RunID.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.RunID.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.RunID} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.RunID.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string run_id = 1;
+ * @return {string}
+ */
+proto.gscheduler.RunID.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.RunID} returns this
+ */
+proto.gscheduler.RunID.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Stop.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Stop.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Stop} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Stop.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    force: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Stop}
+ */
+proto.gscheduler.Stop.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Stop;
+  return proto.gscheduler.Stop.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Stop} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Stop}
+ */
+proto.gscheduler.Stop.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForce(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Stop.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Stop.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Stop} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Stop.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getForce();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool force = 1;
+ * @return {boolean}
+ */
+proto.gscheduler.Stop.prototype.getForce = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.gscheduler.Stop} returns this
+ */
+proto.gscheduler.Stop.prototype.setForce = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.QueuedRun.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.QueuedRun.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.QueuedRun} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.QueuedRun.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    taskUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    app: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    priority: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    queuedTime: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    position: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.QueuedRun}
+ */
+proto.gscheduler.QueuedRun.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.QueuedRun;
+  return proto.gscheduler.QueuedRun.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.QueuedRun} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.QueuedRun}
+ */
+proto.gscheduler.QueuedRun.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTaskUuid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApp(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPriority(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setQueuedTime(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPosition(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.QueuedRun.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.QueuedRun.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.QueuedRun} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.QueuedRun.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTaskUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getApp();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getPriority();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getQueuedTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string run_id = 1;
+ * @return {string}
+ */
+proto.gscheduler.QueuedRun.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string task_uuid = 2;
+ * @return {string}
+ */
+proto.gscheduler.QueuedRun.prototype.getTaskUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setTaskUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.gscheduler.QueuedRun.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string app = 4;
+ * @return {string}
+ */
+proto.gscheduler.QueuedRun.prototype.getApp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setApp = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 priority = 5;
+ * @return {number}
+ */
+proto.gscheduler.QueuedRun.prototype.getPriority = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setPriority = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 queued_time = 6;
+ * @return {number}
+ */
+proto.gscheduler.QueuedRun.prototype.getQueuedTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setQueuedTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 position = 7;
+ * @return {number}
+ */
+proto.gscheduler.QueuedRun.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.QueuedRun} returns this
+ */
+proto.gscheduler.QueuedRun.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.Queue.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Queue.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Queue.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Queue} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Queue.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runsList: jspb.Message.toObjectList(msg.getRunsList(),
+    proto.gscheduler.QueuedRun.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Queue}
+ */
+proto.gscheduler.Queue.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Queue;
+  return proto.gscheduler.Queue.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Queue} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Queue}
+ */
+proto.gscheduler.Queue.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.gscheduler.QueuedRun;
+      reader.readMessage(value,proto.gscheduler.QueuedRun.deserializeBinaryFromReader);
+      msg.addRuns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.gscheduler.Queue.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Queue.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Queue} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.gscheduler.Queue.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.gscheduler.QueuedRun.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated QueuedRun runs = 1;
+ * @return {!Array<!proto.gscheduler.QueuedRun>}
+ */
+proto.gscheduler.Queue.prototype.getRunsList = function() {
+  return /** @type{!Array<!proto.gscheduler.QueuedRun>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.QueuedRun, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.gscheduler.QueuedRun>} value
+ * @return {!proto.gscheduler.Queue} returns this
+*/
+proto.gscheduler.Queue.prototype.setRunsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string msg = 1;
- * @return {string}
+ * @param {!proto.gscheduler.QueuedRun=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.QueuedRun}
  */
-proto.gscheduler.Request.prototype.getMsg = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.gscheduler.Queue.prototype.addRuns = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.gscheduler.QueuedRun, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.Request} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Queue} returns this
  */
-proto.gscheduler.Request.prototype.setMsg = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.gscheduler.Queue.prototype.clearRunsList = function() {
+  return this.setRunsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.gscheduler.List.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -410,8 +6495,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.List.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.List.toObject(opt_includeInstance, this);
+proto.gscheduler.RunningTask.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RunningTask.toObject(opt_includeInstance, this);
 };
 
 
@@ -420,13 +6505,17 @@ proto.gscheduler.List.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.List} msg The msg instance to transform.
+ * @param {!proto.gscheduler.RunningTask} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.List.toObject = function(includeInstance, msg) {
+proto.gscheduler.RunningTask.toObject = function(includeInstance, msg) {
   var f, obj = {
-    dataList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    priority: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    running: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    queued: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -440,23 +6529,23 @@ proto.gscheduler.List.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.List}
+ * @return {!proto.gscheduler.RunningTask}
  */
-proto.gscheduler.List.deserializeBinary = function(bytes) {
+proto.gscheduler.RunningTask.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.List;
-  return proto.gscheduler.List.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.RunningTask;
+  return proto.gscheduler.RunningTask.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.List} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.RunningTask} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.List}
+ * @return {!proto.gscheduler.RunningTask}
  */
-proto.gscheduler.List.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.RunningTask.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -465,7 +6554,23 @@ proto.gscheduler.List.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addData(value);
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPriority(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRunning(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setQueued(value);
       break;
     default:
       reader.skipField();
@@ -480,9 +6585,9 @@ proto.gscheduler.List.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.List.prototype.serializeBinary = function() {
+proto.gscheduler.RunningTask.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.List.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.RunningTask.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -490,59 +6595,147 @@ proto.gscheduler.List.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.List} message
+ * @param {!proto.gscheduler.RunningTask} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.List.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.RunningTask.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDataList();
+  f = message.getUuid();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPriority();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getRunning();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getQueued();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string data = 1;
- * @return {!Array<string>}
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.gscheduler.List.prototype.getDataList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.gscheduler.RunningTask.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.gscheduler.List} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.RunningTask} returns this
  */
-proto.gscheduler.List.prototype.setDataList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.gscheduler.RunningTask.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.gscheduler.RunningTask.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.gscheduler.List} returns this
+ * @return {!proto.gscheduler.RunningTask} returns this
  */
-proto.gscheduler.List.prototype.addData = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.gscheduler.RunningTask.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.gscheduler.List} returns this
+ * optional int64 priority = 3;
+ * @return {number}
  */
-proto.gscheduler.List.prototype.clearDataList = function() {
-  return this.setDataList([]);
+proto.gscheduler.RunningTask.prototype.getPriority = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunningTask} returns this
+ */
+proto.gscheduler.RunningTask.prototype.setPriority = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 running = 4;
+ * @return {number}
+ */
+proto.gscheduler.RunningTask.prototype.getRunning = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunningTask} returns this
+ */
+proto.gscheduler.RunningTask.prototype.setRunning = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
+/**
+ * optional int64 queued = 5;
+ * @return {number}
+ */
+proto.gscheduler.RunningTask.prototype.getQueued = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.RunningTask} returns this
+ */
+proto.gscheduler.RunningTask.prototype.setQueued = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.gscheduler.RunningTasks.repeatedFields_ = [1,2];
 
 
 
@@ -559,8 +6752,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.File.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.File.toObject(opt_includeInstance, this);
+proto.gscheduler.RunningTasks.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.RunningTasks.toObject(opt_includeInstance, this);
 };
 
 
@@ -569,13 +6762,15 @@ proto.gscheduler.File.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.File} msg The msg instance to transform.
+ * @param {!proto.gscheduler.RunningTasks} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.File.toObject = function(includeInstance, msg) {
+proto.gscheduler.RunningTasks.toObject = function(includeInstance, msg) {
   var f, obj = {
-    content: msg.getContent_asB64()
+    dataList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    tasksList: jspb.Message.toObjectList(msg.getTasksList(),
+    proto.gscheduler.RunningTask.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -589,23 +6784,23 @@ proto.gscheduler.File.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.File}
+ * @return {!proto.gscheduler.RunningTasks}
  */
-proto.gscheduler.File.deserializeBinary = function(bytes) {
+proto.gscheduler.RunningTasks.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.File;
-  return proto.gscheduler.File.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.RunningTasks;
+  return proto.gscheduler.RunningTasks.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.File} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.RunningTasks} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.File}
+ * @return {!proto.gscheduler.RunningTasks}
  */
-proto.gscheduler.File.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.RunningTasks.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -613,8 +6808,13 @@ proto.gscheduler.File.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setContent(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addData(value);
+      break;
+    case 2:
+      var value = new proto.gscheduler.RunningTask;
+      reader.readMessage(value,proto.gscheduler.RunningTask.deserializeBinaryFromReader);
+      msg.addTasks(value);
       break;
     default:
       reader.skipField();
@@ -629,9 +6829,9 @@ proto.gscheduler.File.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.File.prototype.serializeBinary = function() {
+proto.gscheduler.RunningTasks.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.File.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.RunningTasks.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -639,173 +6839,106 @@ proto.gscheduler.File.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.File} message
+ * @param {!proto.gscheduler.RunningTasks} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.File.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.RunningTasks.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContent_asU8();
+  f = message.getDataList();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
+  f = message.getTasksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.gscheduler.RunningTask.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bytes content = 1;
- * @return {!(string|Uint8Array)}
- */
-proto.gscheduler.File.prototype.getContent = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * optional bytes content = 1;
- * This is a type-conversion wrapper around `getContent()`
- * @return {string}
- */
-proto.gscheduler.File.prototype.getContent_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getContent()));
-};
-
-
-/**
- * optional bytes content = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getContent()`
- * @return {!Uint8Array}
+ * repeated string data = 1;
+ * @return {!Array<string>}
  */
-proto.gscheduler.File.prototype.getContent_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getContent()));
+proto.gscheduler.RunningTasks.prototype.getDataList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.gscheduler.File} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.RunningTasks} returns this
  */
-proto.gscheduler.File.prototype.setContent = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.gscheduler.RunningTasks.prototype.setDataList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.RunningTasks} returns this
  */
-proto.gscheduler.Empty.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Empty.toObject(opt_includeInstance, this);
+proto.gscheduler.RunningTasks.prototype.addData = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Empty} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.RunningTasks} returns this
  */
-proto.gscheduler.Empty.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.gscheduler.RunningTasks.prototype.clearDataList = function() {
+  return this.setDataList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Empty}
+ * repeated RunningTask tasks = 2;
+ * @return {!Array<!proto.gscheduler.RunningTask>}
  */
-proto.gscheduler.Empty.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Empty;
-  return proto.gscheduler.Empty.deserializeBinaryFromReader(msg, reader);
+proto.gscheduler.RunningTasks.prototype.getTasksList = function() {
+  return /** @type{!Array<!proto.gscheduler.RunningTask>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.RunningTask, 2));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.gscheduler.Empty} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Empty}
- */
-proto.gscheduler.Empty.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.gscheduler.RunningTask>} value
+ * @return {!proto.gscheduler.RunningTasks} returns this
+*/
+proto.gscheduler.RunningTasks.prototype.setTasksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.gscheduler.RunningTask=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.RunningTask}
  */
-proto.gscheduler.Empty.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Empty.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.gscheduler.RunningTasks.prototype.addTasks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.gscheduler.RunningTask, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Empty} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.RunningTasks} returns this
  */
-proto.gscheduler.Empty.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.gscheduler.RunningTasks.prototype.clearTasksList = function() {
+  return this.setTasksList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.gscheduler.Task.repeatedFields_ = [8];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -821,8 +6954,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.Task.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Task.toObject(opt_includeInstance, this);
+proto.gscheduler.ScheduleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.ScheduleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -831,24 +6964,16 @@ proto.gscheduler.Task.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Task} msg The msg instance to transform.
+ * @param {!proto.gscheduler.ScheduleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Task.toObject = function(includeInstance, msg) {
+proto.gscheduler.ScheduleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    tagsMap: (f = msg.getTagsMap()) ? f.toObject(includeInstance, undefined) : [],
-    schedule: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    timeout: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    app: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    workDir: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    argsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
-    nextTask: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    cronId: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    enabled: jspb.Message.getBooleanFieldWithDefault(msg, 12, false)
+    schedule: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    timezone: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    count: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    taskUuid: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -862,23 +6987,23 @@ proto.gscheduler.Task.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Task}
+ * @return {!proto.gscheduler.ScheduleRequest}
  */
-proto.gscheduler.Task.deserializeBinary = function(bytes) {
+proto.gscheduler.ScheduleRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Task;
-  return proto.gscheduler.Task.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.ScheduleRequest;
+  return proto.gscheduler.ScheduleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.Task} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.ScheduleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Task}
+ * @return {!proto.gscheduler.ScheduleRequest}
  */
-proto.gscheduler.Task.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.ScheduleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -887,53 +7012,19 @@ proto.gscheduler.Task.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setSchedule(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      msg.setTimezone(value);
       break;
     case 3:
-      var value = msg.getTagsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSchedule(value);
-      break;
-    case 5:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setTimeout(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApp(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setWorkDir(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addArgs(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNextTask(value);
+      msg.setCount(value);
       break;
-    case 10:
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
-      break;
-    case 11:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCronId(value);
-      break;
-    case 12:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setEnabled(value);
+      msg.setTaskUuid(value);
       break;
     default:
       reader.skipField();
@@ -948,9 +7039,9 @@ proto.gscheduler.Task.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.Task.prototype.serializeBinary = function() {
+proto.gscheduler.ScheduleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Task.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.ScheduleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -958,90 +7049,37 @@ proto.gscheduler.Task.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Task} message
+ * @param {!proto.gscheduler.ScheduleRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Task.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.ScheduleRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getSchedule();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDescription();
+  f = message.getTimezone();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getTagsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getSchedule();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getTimeout();
+  f = message.getCount();
   if (f !== 0) {
     writer.writeInt64(
-      5,
-      f
-    );
-  }
-  f = message.getApp();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getWorkDir();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      8,
-      f
-    );
-  }
-  f = message.getNextTask();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
+      3,
       f
     );
   }
-  f = message.getUuid();
+  f = message.getTaskUuid();
   if (f.length > 0) {
     writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getCronId();
-  if (f !== 0) {
-    writer.writeInt64(
-      11,
-      f
-    );
-  }
-  f = message.getEnabled();
-  if (f) {
-    writer.writeBool(
-      12,
+      4,
       f
     );
   }
@@ -1049,251 +7087,235 @@ proto.gscheduler.Task.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string name = 1;
- * @return {string}
- */
-proto.gscheduler.Task.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
- */
-proto.gscheduler.Task.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string description = 2;
- * @return {string}
- */
-proto.gscheduler.Task.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
- */
-proto.gscheduler.Task.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * map<string, string> tags = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
- */
-proto.gscheduler.Task.prototype.getTagsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.gscheduler.Task} returns this
- */
-proto.gscheduler.Task.prototype.clearTagsMap = function() {
-  this.getTagsMap().clear();
-  return this;};
-
-
-/**
- * optional string schedule = 4;
- * @return {string}
- */
-proto.gscheduler.Task.prototype.getSchedule = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
- */
-proto.gscheduler.Task.prototype.setSchedule = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional int64 timeout = 5;
- * @return {number}
+ * optional string schedule = 1;
+ * @return {string}
  */
-proto.gscheduler.Task.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.gscheduler.ScheduleRequest.prototype.getSchedule = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.gscheduler.Task} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.ScheduleRequest} returns this
  */
-proto.gscheduler.Task.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.gscheduler.ScheduleRequest.prototype.setSchedule = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string app = 6;
+ * optional string timezone = 2;
  * @return {string}
  */
-proto.gscheduler.Task.prototype.getApp = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.gscheduler.ScheduleRequest.prototype.getTimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
+ * @return {!proto.gscheduler.ScheduleRequest} returns this
  */
-proto.gscheduler.Task.prototype.setApp = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.gscheduler.ScheduleRequest.prototype.setTimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string work_dir = 7;
- * @return {string}
+ * optional int64 count = 3;
+ * @return {number}
  */
-proto.gscheduler.Task.prototype.getWorkDir = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.gscheduler.ScheduleRequest.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
+ * @param {number} value
+ * @return {!proto.gscheduler.ScheduleRequest} returns this
  */
-proto.gscheduler.Task.prototype.setWorkDir = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.gscheduler.ScheduleRequest.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * repeated string args = 8;
- * @return {!Array<string>}
+ * optional string task_uuid = 4;
+ * @return {string}
  */
-proto.gscheduler.Task.prototype.getArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+proto.gscheduler.ScheduleRequest.prototype.getTaskUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.gscheduler.Task} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.ScheduleRequest} returns this
  */
-proto.gscheduler.Task.prototype.setArgsList = function(value) {
-  return jspb.Message.setField(this, 8, value || []);
+proto.gscheduler.ScheduleRequest.prototype.setTaskUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
+
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.gscheduler.Task} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.gscheduler.Task.prototype.addArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
-};
+proto.gscheduler.ScheduleRuns.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.gscheduler.Task} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.gscheduler.Task.prototype.clearArgsList = function() {
-  return this.setArgsList([]);
+proto.gscheduler.ScheduleRuns.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.ScheduleRuns.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string next_task = 9;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.ScheduleRuns} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Task.prototype.getNextTask = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.gscheduler.ScheduleRuns.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.ScheduleRuns}
  */
-proto.gscheduler.Task.prototype.setNextTask = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.gscheduler.ScheduleRuns.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.ScheduleRuns;
+  return proto.gscheduler.ScheduleRuns.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string uuid = 10;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.ScheduleRuns} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.ScheduleRuns}
  */
-proto.gscheduler.Task.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.gscheduler.ScheduleRuns.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt64() : [reader.readInt64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addRuns(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.Task} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.gscheduler.Task.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.gscheduler.ScheduleRuns.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.ScheduleRuns.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional int64 cron_id = 11;
- * @return {number}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.ScheduleRuns} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Task.prototype.getCronId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.gscheduler.ScheduleRuns.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunsList();
+  if (f.length > 0) {
+    writer.writePackedInt64(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.gscheduler.Task} returns this
+ * repeated int64 runs = 1;
+ * @return {!Array<number>}
  */
-proto.gscheduler.Task.prototype.setCronId = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.gscheduler.ScheduleRuns.prototype.getRunsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * optional bool enabled = 12;
- * @return {boolean}
+ * @param {!Array<number>} value
+ * @return {!proto.gscheduler.ScheduleRuns} returns this
  */
-proto.gscheduler.Task.prototype.getEnabled = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+proto.gscheduler.ScheduleRuns.prototype.setRunsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.gscheduler.Task} returns this
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.ScheduleRuns} returns this
  */
-proto.gscheduler.Task.prototype.setEnabled = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 12, value);
+proto.gscheduler.ScheduleRuns.prototype.addRuns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.ScheduleRuns} returns this
  */
-proto.gscheduler.Tasks.repeatedFields_ = [1];
+proto.gscheduler.ScheduleRuns.prototype.clearRunsList = function() {
+  return this.setRunsList([]);
+};
+
+
 
 
 
@@ -1310,8 +7332,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.Tasks.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Tasks.toObject(opt_includeInstance, this);
+proto.gscheduler.SchedulerState.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.SchedulerState.toObject(opt_includeInstance, this);
 };
 
 
@@ -1320,14 +7342,16 @@ proto.gscheduler.Tasks.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Tasks} msg The msg instance to transform.
+ * @param {!proto.gscheduler.SchedulerState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Tasks.toObject = function(includeInstance, msg) {
+proto.gscheduler.SchedulerState.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tasksList: jspb.Message.toObjectList(msg.getTasksList(),
-    proto.gscheduler.Task.toObject, includeInstance)
+    state: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    running: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    queued: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    since: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -1341,23 +7365,23 @@ proto.gscheduler.Tasks.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Tasks}
+ * @return {!proto.gscheduler.SchedulerState}
  */
-proto.gscheduler.Tasks.deserializeBinary = function(bytes) {
+proto.gscheduler.SchedulerState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Tasks;
-  return proto.gscheduler.Tasks.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.SchedulerState;
+  return proto.gscheduler.SchedulerState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.Tasks} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.SchedulerState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Tasks}
+ * @return {!proto.gscheduler.SchedulerState}
  */
-proto.gscheduler.Tasks.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.SchedulerState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1365,9 +7389,20 @@ proto.gscheduler.Tasks.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.gscheduler.Task;
-      reader.readMessage(value,proto.gscheduler.Task.deserializeBinaryFromReader);
-      msg.addTasks(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setState(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRunning(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setQueued(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSince(value);
       break;
     default:
       reader.skipField();
@@ -1382,9 +7417,9 @@ proto.gscheduler.Tasks.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.Tasks.prototype.serializeBinary = function() {
+proto.gscheduler.SchedulerState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Tasks.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.SchedulerState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1392,58 +7427,112 @@ proto.gscheduler.Tasks.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Tasks} message
+ * @param {!proto.gscheduler.SchedulerState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Tasks.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.SchedulerState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTasksList();
+  f = message.getState();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.gscheduler.Task.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getRunning();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getQueued();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getSince();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated Task tasks = 1;
- * @return {!Array<!proto.gscheduler.Task>}
+ * optional string state = 1;
+ * @return {string}
  */
-proto.gscheduler.Tasks.prototype.getTasksList = function() {
-  return /** @type{!Array<!proto.gscheduler.Task>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.Task, 1));
+proto.gscheduler.SchedulerState.prototype.getState = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.gscheduler.Task>} value
- * @return {!proto.gscheduler.Tasks} returns this
-*/
-proto.gscheduler.Tasks.prototype.setTasksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.gscheduler.SchedulerState} returns this
+ */
+proto.gscheduler.SchedulerState.prototype.setState = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 running = 2;
+ * @return {number}
+ */
+proto.gscheduler.SchedulerState.prototype.getRunning = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.SchedulerState} returns this
+ */
+proto.gscheduler.SchedulerState.prototype.setRunning = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 queued = 3;
+ * @return {number}
+ */
+proto.gscheduler.SchedulerState.prototype.getQueued = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.gscheduler.SchedulerState} returns this
+ */
+proto.gscheduler.SchedulerState.prototype.setQueued = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.gscheduler.Task=} opt_value
- * @param {number=} opt_index
- * @return {!proto.gscheduler.Task}
+ * optional int64 since = 4;
+ * @return {number}
  */
-proto.gscheduler.Tasks.prototype.addTasks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.gscheduler.Task, opt_index);
+proto.gscheduler.SchedulerState.prototype.getSince = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.gscheduler.Tasks} returns this
+ * @param {number} value
+ * @return {!proto.gscheduler.SchedulerState} returns this
  */
-proto.gscheduler.Tasks.prototype.clearTasksList = function() {
-  return this.setTasksList([]);
+proto.gscheduler.SchedulerState.prototype.setSince = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -1463,8 +7552,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.TaskUUID.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.TaskUUID.toObject(opt_includeInstance, this);
+proto.gscheduler.Secret.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Secret.toObject(opt_includeInstance, this);
 };
 
 
@@ -1473,14 +7562,14 @@ proto.gscheduler.TaskUUID.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.TaskUUID} msg The msg instance to transform.
+ * @param {!proto.gscheduler.Secret} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskUUID.toObject = function(includeInstance, msg) {
+proto.gscheduler.Secret.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    force: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1494,23 +7583,23 @@ proto.gscheduler.TaskUUID.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.TaskUUID}
+ * @return {!proto.gscheduler.Secret}
  */
-proto.gscheduler.TaskUUID.deserializeBinary = function(bytes) {
+proto.gscheduler.Secret.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.TaskUUID;
-  return proto.gscheduler.TaskUUID.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.Secret;
+  return proto.gscheduler.Secret.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.TaskUUID} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.Secret} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.TaskUUID}
+ * @return {!proto.gscheduler.Secret}
  */
-proto.gscheduler.TaskUUID.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.Secret.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1519,11 +7608,11 @@ proto.gscheduler.TaskUUID.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForce(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -1538,9 +7627,9 @@ proto.gscheduler.TaskUUID.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.TaskUUID.prototype.serializeBinary = function() {
+proto.gscheduler.Secret.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.TaskUUID.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.Secret.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1548,22 +7637,22 @@ proto.gscheduler.TaskUUID.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.TaskUUID} message
+ * @param {!proto.gscheduler.Secret} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskUUID.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.Secret.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getForce();
-  if (f) {
-    writer.writeBool(
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -1572,38 +7661,38 @@ proto.gscheduler.TaskUUID.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string uuid = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.gscheduler.TaskUUID.prototype.getUuid = function() {
+proto.gscheduler.Secret.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.TaskUUID} returns this
+ * @return {!proto.gscheduler.Secret} returns this
  */
-proto.gscheduler.TaskUUID.prototype.setUuid = function(value) {
+proto.gscheduler.Secret.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool force = 2;
- * @return {boolean}
+ * optional string value = 2;
+ * @return {string}
  */
-proto.gscheduler.TaskUUID.prototype.getForce = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.gscheduler.Secret.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.gscheduler.TaskUUID} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.Secret} returns this
  */
-proto.gscheduler.TaskUUID.prototype.setForce = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.gscheduler.Secret.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -1623,8 +7712,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.Status.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Status.toObject(opt_includeInstance, this);
+proto.gscheduler.SecretName.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.SecretName.toObject(opt_includeInstance, this);
 };
 
 
@@ -1633,15 +7722,13 @@ proto.gscheduler.Status.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Status} msg The msg instance to transform.
+ * @param {!proto.gscheduler.SecretName} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Status.toObject = function(includeInstance, msg) {
+proto.gscheduler.SecretName.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    error: jspb.Message.getFieldWithDefault(msg, 3, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1655,23 +7742,23 @@ proto.gscheduler.Status.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Status}
+ * @return {!proto.gscheduler.SecretName}
  */
-proto.gscheduler.Status.deserializeBinary = function(bytes) {
+proto.gscheduler.SecretName.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Status;
-  return proto.gscheduler.Status.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.SecretName;
+  return proto.gscheduler.SecretName.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.Status} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.SecretName} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Status}
+ * @return {!proto.gscheduler.SecretName}
  */
-proto.gscheduler.Status.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.SecretName.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1680,15 +7767,7 @@ proto.gscheduler.Status.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setError(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -1703,9 +7782,9 @@ proto.gscheduler.Status.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.Status.prototype.serializeBinary = function() {
+proto.gscheduler.SecretName.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Status.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.SecretName.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1713,90 +7792,47 @@ proto.gscheduler.Status.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Status} message
+ * @param {!proto.gscheduler.SecretName} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Status.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.SecretName.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getError();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.gscheduler.Status.prototype.getUuid = function() {
+proto.gscheduler.SecretName.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.Status} returns this
+ * @return {!proto.gscheduler.SecretName} returns this
  */
-proto.gscheduler.Status.prototype.setUuid = function(value) {
+proto.gscheduler.SecretName.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string message = 2;
- * @return {string}
- */
-proto.gscheduler.Status.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.gscheduler.Status} returns this
- */
-proto.gscheduler.Status.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string error = 3;
- * @return {string}
- */
-proto.gscheduler.Status.prototype.getError = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.Status} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.gscheduler.Status.prototype.setError = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
+proto.gscheduler.Calendar.repeatedFields_ = [3,4];
 
 
 
@@ -1813,8 +7849,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.ExecStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.ExecStatus.toObject(opt_includeInstance, this);
+proto.gscheduler.Calendar.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Calendar.toObject(opt_includeInstance, this);
 };
 
 
@@ -1823,15 +7859,18 @@ proto.gscheduler.ExecStatus.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.ExecStatus} msg The msg instance to transform.
+ * @param {!proto.gscheduler.Calendar} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.ExecStatus.toObject = function(includeInstance, msg) {
+proto.gscheduler.Calendar.toObject = function(includeInstance, msg) {
   var f, obj = {
-    stderr: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stdout: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    exitCode: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    datesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    windowsList: jspb.Message.toObjectList(msg.getWindowsList(),
+    proto.gscheduler.CalendarWindow.toObject, includeInstance),
+    timezone: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -1845,23 +7884,23 @@ proto.gscheduler.ExecStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.ExecStatus}
+ * @return {!proto.gscheduler.Calendar}
  */
-proto.gscheduler.ExecStatus.deserializeBinary = function(bytes) {
+proto.gscheduler.Calendar.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.ExecStatus;
-  return proto.gscheduler.ExecStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.Calendar;
+  return proto.gscheduler.Calendar.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.ExecStatus} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.Calendar} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.ExecStatus}
+ * @return {!proto.gscheduler.Calendar}
  */
-proto.gscheduler.ExecStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.Calendar.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1870,15 +7909,24 @@ proto.gscheduler.ExecStatus.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setStderr(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setStdout(value);
+      msg.setDescription(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setExitCode(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDates(value);
+      break;
+    case 4:
+      var value = new proto.gscheduler.CalendarWindow;
+      reader.readMessage(value,proto.gscheduler.CalendarWindow.deserializeBinaryFromReader);
+      msg.addWindows(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimezone(value);
       break;
     default:
       reader.skipField();
@@ -1893,9 +7941,9 @@ proto.gscheduler.ExecStatus.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.ExecStatus.prototype.serializeBinary = function() {
+proto.gscheduler.Calendar.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.ExecStatus.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.Calendar.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1903,87 +7951,177 @@ proto.gscheduler.ExecStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.ExecStatus} message
+ * @param {!proto.gscheduler.Calendar} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.ExecStatus.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.Calendar.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStderr();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getStdout();
+  f = message.getDescription();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getDatesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       3,
       f
     );
   }
+  f = message.getWindowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.gscheduler.CalendarWindow.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimezone();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string stderr = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.gscheduler.ExecStatus.prototype.getStderr = function() {
+proto.gscheduler.Calendar.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.ExecStatus} returns this
+ * @return {!proto.gscheduler.Calendar} returns this
  */
-proto.gscheduler.ExecStatus.prototype.setStderr = function(value) {
+proto.gscheduler.Calendar.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string stdout = 2;
+ * optional string description = 2;
  * @return {string}
  */
-proto.gscheduler.ExecStatus.prototype.getStdout = function() {
+proto.gscheduler.Calendar.prototype.getDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.ExecStatus} returns this
+ * @return {!proto.gscheduler.Calendar} returns this
  */
-proto.gscheduler.ExecStatus.prototype.setStdout = function(value) {
+proto.gscheduler.Calendar.prototype.setDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional int64 exit_code = 3;
- * @return {number}
+ * repeated string dates = 3;
+ * @return {!Array<string>}
+ */
+proto.gscheduler.Calendar.prototype.getDatesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.gscheduler.Calendar} returns this
+ */
+proto.gscheduler.Calendar.prototype.setDatesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Calendar} returns this
+ */
+proto.gscheduler.Calendar.prototype.addDates = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Calendar} returns this
+ */
+proto.gscheduler.Calendar.prototype.clearDatesList = function() {
+  return this.setDatesList([]);
+};
+
+
+/**
+ * repeated CalendarWindow windows = 4;
+ * @return {!Array<!proto.gscheduler.CalendarWindow>}
+ */
+proto.gscheduler.Calendar.prototype.getWindowsList = function() {
+  return /** @type{!Array<!proto.gscheduler.CalendarWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.CalendarWindow, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.gscheduler.CalendarWindow>} value
+ * @return {!proto.gscheduler.Calendar} returns this
+*/
+proto.gscheduler.Calendar.prototype.setWindowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.gscheduler.CalendarWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.CalendarWindow}
+ */
+proto.gscheduler.Calendar.prototype.addWindows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.gscheduler.CalendarWindow, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Calendar} returns this
+ */
+proto.gscheduler.Calendar.prototype.clearWindowsList = function() {
+  return this.setWindowsList([]);
+};
+
+
+/**
+ * optional string timezone = 5;
+ * @return {string}
  */
-proto.gscheduler.ExecStatus.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.gscheduler.Calendar.prototype.getTimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.gscheduler.ExecStatus} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.Calendar} returns this
  */
-proto.gscheduler.ExecStatus.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.gscheduler.Calendar.prototype.setTimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -2003,8 +8141,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.TaskLog.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.TaskLog.toObject(opt_includeInstance, this);
+proto.gscheduler.CalendarWindow.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.CalendarWindow.toObject(opt_includeInstance, this);
 };
 
 
@@ -2013,18 +8151,14 @@ proto.gscheduler.TaskLog.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.TaskLog} msg The msg instance to transform.
+ * @param {!proto.gscheduler.CalendarWindow} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskLog.toObject = function(includeInstance, msg) {
+proto.gscheduler.CalendarWindow.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    tagsMap: (f = msg.getTagsMap()) ? f.toObject(includeInstance, undefined) : [],
-    uuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    message: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    timestamp: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    start: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    duration: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -2038,23 +8172,23 @@ proto.gscheduler.TaskLog.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.TaskLog}
+ * @return {!proto.gscheduler.CalendarWindow}
  */
-proto.gscheduler.TaskLog.deserializeBinary = function(bytes) {
+proto.gscheduler.CalendarWindow.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.TaskLog;
-  return proto.gscheduler.TaskLog.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.CalendarWindow;
+  return proto.gscheduler.CalendarWindow.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.TaskLog} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.CalendarWindow} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.TaskLog}
+ * @return {!proto.gscheduler.CalendarWindow}
  */
-proto.gscheduler.TaskLog.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.CalendarWindow.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2063,29 +8197,11 @@ proto.gscheduler.TaskLog.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setStart(value);
       break;
     case 2:
-      var value = msg.getTagsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
-      break;
-    case 6:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setTimestamp(value);
+      msg.setDuration(value);
       break;
     default:
       reader.skipField();
@@ -2100,9 +8216,9 @@ proto.gscheduler.TaskLog.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.TaskLog.prototype.serializeBinary = function() {
+proto.gscheduler.CalendarWindow.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.TaskLog.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.CalendarWindow.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2110,48 +8226,23 @@ proto.gscheduler.TaskLog.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.TaskLog} message
+ * @param {!proto.gscheduler.CalendarWindow} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskLog.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.CalendarWindow.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getStart();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getTagsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getTimestamp();
+  f = message.getDuration();
   if (f !== 0) {
     writer.writeInt64(
-      6,
+      2,
       f
     );
   }
@@ -2159,114 +8250,198 @@ proto.gscheduler.TaskLog.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string name = 1;
+ * optional string start = 1;
  * @return {string}
  */
-proto.gscheduler.TaskLog.prototype.getName = function() {
+proto.gscheduler.CalendarWindow.prototype.getStart = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.gscheduler.TaskLog} returns this
+ * @return {!proto.gscheduler.CalendarWindow} returns this
  */
-proto.gscheduler.TaskLog.prototype.setName = function(value) {
+proto.gscheduler.CalendarWindow.prototype.setStart = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, string> tags = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional int64 duration = 2;
+ * @return {number}
  */
-proto.gscheduler.TaskLog.prototype.getTagsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.gscheduler.CalendarWindow.prototype.getDuration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.gscheduler.TaskLog} returns this
+ * @param {number} value
+ * @return {!proto.gscheduler.CalendarWindow} returns this
  */
-proto.gscheduler.TaskLog.prototype.clearTagsMap = function() {
-  this.getTagsMap().clear();
-  return this;};
+proto.gscheduler.CalendarWindow.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
 
 
 /**
- * optional string uuid = 3;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.gscheduler.TaskLog.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.gscheduler.Calendars.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.gscheduler.Calendars.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.Calendars.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.TaskLog} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.gscheduler.Calendars} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskLog.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.gscheduler.Calendars.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    calendarsList: jspb.Message.toObjectList(msg.getCalendarsList(),
+    proto.gscheduler.Calendar.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string type = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.gscheduler.Calendars}
  */
-proto.gscheduler.TaskLog.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.gscheduler.Calendars.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.gscheduler.Calendars;
+  return proto.gscheduler.Calendars.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.TaskLog} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.gscheduler.Calendars} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.gscheduler.Calendars}
  */
-proto.gscheduler.TaskLog.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.gscheduler.Calendars.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.gscheduler.Calendar;
+      reader.readMessage(value,proto.gscheduler.Calendar.deserializeBinaryFromReader);
+      msg.addCalendars(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string message = 5;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.gscheduler.TaskLog.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.gscheduler.Calendars.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.gscheduler.Calendars.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.gscheduler.TaskLog} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.gscheduler.Calendars} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.TaskLog.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.gscheduler.Calendars.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCalendarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.gscheduler.Calendar.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional int64 timestamp = 6;
- * @return {number}
+ * repeated Calendar calendars = 1;
+ * @return {!Array<!proto.gscheduler.Calendar>}
  */
-proto.gscheduler.TaskLog.prototype.getTimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.gscheduler.Calendars.prototype.getCalendarsList = function() {
+  return /** @type{!Array<!proto.gscheduler.Calendar>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.gscheduler.Calendar, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.gscheduler.TaskLog} returns this
+ * @param {!Array<!proto.gscheduler.Calendar>} value
+ * @return {!proto.gscheduler.Calendars} returns this
+*/
+proto.gscheduler.Calendars.prototype.setCalendarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.gscheduler.Calendar=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.gscheduler.Calendar}
  */
-proto.gscheduler.TaskLog.prototype.setTimestamp = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.gscheduler.Calendars.prototype.addCalendars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.gscheduler.Calendar, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.gscheduler.Calendars} returns this
+ */
+proto.gscheduler.Calendars.prototype.clearCalendarsList = function() {
+  return this.setCalendarsList([]);
 };
 
 
@@ -2286,8 +8461,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.gscheduler.Stop.prototype.toObject = function(opt_includeInstance) {
-  return proto.gscheduler.Stop.toObject(opt_includeInstance, this);
+proto.gscheduler.CalendarName.prototype.toObject = function(opt_includeInstance) {
+  return proto.gscheduler.CalendarName.toObject(opt_includeInstance, this);
 };
 
 
@@ -2296,13 +8471,13 @@ proto.gscheduler.Stop.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.gscheduler.Stop} msg The msg instance to transform.
+ * @param {!proto.gscheduler.CalendarName} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Stop.toObject = function(includeInstance, msg) {
+proto.gscheduler.CalendarName.toObject = function(includeInstance, msg) {
   var f, obj = {
-    force: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2316,23 +8491,23 @@ proto.gscheduler.Stop.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.gscheduler.Stop}
+ * @return {!proto.gscheduler.CalendarName}
  */
-proto.gscheduler.Stop.deserializeBinary = function(bytes) {
+proto.gscheduler.CalendarName.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.gscheduler.Stop;
-  return proto.gscheduler.Stop.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.gscheduler.CalendarName;
+  return proto.gscheduler.CalendarName.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.gscheduler.Stop} msg The message object to deserialize into.
+ * @param {!proto.gscheduler.CalendarName} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.gscheduler.Stop}
+ * @return {!proto.gscheduler.CalendarName}
  */
-proto.gscheduler.Stop.deserializeBinaryFromReader = function(msg, reader) {
+proto.gscheduler.CalendarName.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2340,8 +8515,8 @@ proto.gscheduler.Stop.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForce(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -2356,9 +8531,9 @@ proto.gscheduler.Stop.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.gscheduler.Stop.prototype.serializeBinary = function() {
+proto.gscheduler.CalendarName.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.gscheduler.Stop.serializeBinaryToWriter(this, writer);
+  proto.gscheduler.CalendarName.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2366,15 +8541,15 @@ proto.gscheduler.Stop.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.gscheduler.Stop} message
+ * @param {!proto.gscheduler.CalendarName} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.gscheduler.Stop.serializeBinaryToWriter = function(message, writer) {
+proto.gscheduler.CalendarName.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getForce();
-  if (f) {
-    writer.writeBool(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -2383,21 +8558,35 @@ proto.gscheduler.Stop.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional bool force = 1;
- * @return {boolean}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.gscheduler.Stop.prototype.getForce = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.gscheduler.CalendarName.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.gscheduler.Stop} returns this
+ * @param {string} value
+ * @return {!proto.gscheduler.CalendarName} returns this
  */
-proto.gscheduler.Stop.prototype.setForce = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.gscheduler.CalendarName.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.gscheduler.RunReason = {
+  REASON_UNKNOWN: 0,
+  REASON_SUCCESS: 1,
+  REASON_NON_ZERO_EXIT: 2,
+  REASON_TIMEOUT: 3,
+  REASON_CANCELLED: 4,
+  REASON_FAILED_TO_START: 5,
+  REASON_SKIPPED: 6,
+  REASON_OOM_KILLED: 7
+};
+
 goog.object.extend(exports, proto.gscheduler);
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Email alerts. Final failure/timeout of task (after all retries) is picked from taskLog (tasksLogWatch)
+// and sent to smtp default recipients and task alert_emails. Alerts of one task are rate limited,
+// suppressed alerts are counted and reported in next email.
+// tasksLogWatch only queues failure events, task and run are looked up by worker (taskLog is sent under tasks lock).
+
+type (
+	tSMTPConfig struct {
+		Host        string   `yaml:"host"`         // Empty = email alerts disabled
+		Port        int      `yaml:"port"`         // Default 25
+		StartTLS    bool     `yaml:"starttls"`     // Upgrade connection by STARTTLS
+		Username    string   `yaml:"username"`     // If set, PLAIN auth is used
+		Password    string   `yaml:"password"`     // Password for PLAIN auth
+		From        string   `yaml:"from"`         // Sender address
+		To          []string `yaml:"to"`           // Default recipients (all tasks)
+		StderrLines int      `yaml:"stderr_lines"` // Number of last stderr lines in email (default 20)
+		RateLimit   int64    `yaml:"rate_limit"`   // Min. seconds between alerts of one task (default 300)
+	}
+	tAlerts struct {
+		mutex    sync.Mutex
+		queue    chan *pb.TaskLog
+		lastSent map[string]time.Time // Task UUID -> time of last alert
+		skipped  map[string]int       // Task UUID -> alerts suppressed by rate limit
+	}
+	tAlert struct {
+		to      []string
+		subject string
+		body    string
+	}
+)
+
+func newAlerts() *tAlerts {
+	a := &tAlerts{queue: make(chan *pb.TaskLog, 100), lastSent: make(map[string]time.Time), skipped: make(map[string]int)}
+	go a.worker()
+	return a
+}
+
+// Queue task log message for worker (if message is failure of task, result is set only after last attempt). Never blocks
+func (a *tAlerts) notify(data *pb.TaskLog) {
+	if config.SMTP.Host == "" || data.GetUuid() == "" || data.GetResult() == nil {
+		return
+	}
+	switch data.GetResult().GetReason() {
+	case pb.RunReason_REASON_NON_ZERO_EXIT, pb.RunReason_REASON_TIMEOUT, pb.RunReason_REASON_FAILED_TO_START:
+	default:
+		return
+	}
+	select {
+	case a.queue <- data:
+	default:
+		logger.Warningf("alertQueueFull: alert dropped: %s", data.GetUuid())
+	}
+}
+
+// Create alert for failure of task (nil if task will be retried, has no recipients or is rate limited)
+func (a *tAlerts) alert(data *pb.TaskLog) *tAlert {
+	task := tasks.get(data.GetUuid())
+	if task == nil {
+		return nil
+	}
+	to := append(append([]string{}, config.SMTP.To...), task.GetAlertEmails()...)
+	if len(to) == 0 {
+		return nil
+	}
+	suppressed, ok := a.allow(task.GetUuid())
+	if !ok {
+		return nil
+	}
+	return &tAlert{
+		to:      to,
+		subject: fmt.Sprintf("gScheduler: %s %s", task.GetName(), alertReason(data.GetResult().GetReason())),
+		body:    alertBody(task, data, suppressed),
+	}
+}
+
+// Check rate limit of task. Return number of alerts suppressed since last sent alert
+func (a *tAlerts) allow(taskUUID string) (int, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	rateLimit := time.Duration(config.SMTP.RateLimit) * time.Second
+	if config.SMTP.RateLimit == 0 {
+		rateLimit = 300 * time.Second
+	}
+	if time.Since(a.lastSent[taskUUID]) < rateLimit {
+		a.skipped[taskUUID]++
+		return 0, false
+	}
+	suppressed := a.skipped[taskUUID]
+	a.lastSent[taskUUID] = time.Now()
+	delete(a.skipped, taskUUID)
+	return suppressed, true
+}
+
+func (a *tAlerts) worker() {
+	for data := range a.queue {
+		alert := a.alert(data)
+		if alert == nil {
+			continue
+		}
+		if err := sendMail(config.SMTP, alert); err != nil {
+			logger.Errorf("alertSend: %s", err.Error())
+		}
+	}
+}
+
+func alertReason(reason pb.RunReason) string {
+	switch reason {
+	case pb.RunReason_REASON_TIMEOUT:
+		return "timed out"
+	case pb.RunReason_REASON_FAILED_TO_START:
+		return "failed to start"
+	default:
+		return "failed"
+	}
+}
+
+func alertBody(task *pb.Task, data *pb.TaskLog, suppressed int) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Task: %s (%s)\r\n", task.GetName(), task.GetUuid())
+	fmt.Fprintf(&body, "Run: %s, attempt: %d\r\n", data.GetRunId(), data.GetAttempt())
+	fmt.Fprintf(&body, "Time: %s\r\n", time.UnixMicro(data.GetTimestamp()).Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(&body, "Result: %s, exit code: %d", data.GetResult().GetReason().String(), data.GetResult().GetExitCode())
+	if data.GetResult().GetSignal() != "" {
+		fmt.Fprintf(&body, ", signal: %s", data.GetResult().GetSignal())
+	}
+	fmt.Fprintf(&body, "\r\nMessage: %s\r\n", data.GetMessage())
+	if suppressed > 0 {
+		fmt.Fprintf(&body, "Suppressed alerts since last email: %d\r\n", suppressed)
+	}
+	stderr := tailLines(runs.get(data.GetRunId()).GetStderrTail(), config.SMTP.StderrLines)
+	if stderr != "" {
+		body.WriteString("\r\nStderr (last lines):\r\n")
+		body.WriteString(strings.ReplaceAll(stderr, "\n", "\r\n"))
+		body.WriteString("\r\n")
+	}
+	return body.String()
+}
+
+// Return last n lines of text (default 20)
+func tailLines(text string, n int) string {
+	if n < 1 {
+		n = 20
+	}
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Send email by SMTP (optionally STARTTLS and PLAIN auth)
+func sendMail(cfg tSMTPConfig, alert *tAlert) error {
+	port := cfg.Port
+	if port == 0 {
+		port = 25
+	}
+	client, err := smtp.Dial(net.JoinHostPort(cfg.Host, strconv.Itoa(port)))
+	if err != nil {
+		return fmt.Errorf("dial: %s", err.Error())
+	}
+	defer client.Close()
+	if cfg.StartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: cfg.Host}); err != nil {
+			return fmt.Errorf("starttls: %s", err.Error())
+		}
+	}
+	if cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("auth: %s", err.Error())
+		}
+	}
+	if err := client.Mail(cfg.From); err != nil {
+		return fmt.Errorf("mailFrom: %s", err.Error())
+	}
+	for _, to := range alert.to {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("rcptTo: %s", err.Error())
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("data: %s", err.Error())
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(alert.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", alert.subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(alert.body)
+	if _, err := w.Write(msg.Bytes()); err != nil {
+		return fmt.Errorf("write: %s", err.Error())
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("dataClose: %s", err.Error())
+	}
+	return client.Quit()
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Minimal SMTP server, sends DATA of each received email to channel
+func fakeSMTP(t *testing.T) (int, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err.Error())
+	}
+	t.Cleanup(func() { listener.Close() })
+	mails := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go fakeSMTPSession(conn, mails)
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, mails
+}
+
+func fakeSMTPSession(conn net.Conn, mails chan string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(cmd, "DATA"):
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			mails <- data.String()
+			reply("250 queued")
+		case strings.HasPrefix(cmd, "QUIT"):
+			reply("221 bye")
+			return
+		default: // EHLO, MAIL, RCPT
+			reply("250 OK")
+		}
+	}
+}
+
+func TestAlertRateLimitAndStderr(t *testing.T) {
+	port, mails := fakeSMTP(t)
+	smtpConfig := config.SMTP
+	t.Cleanup(func() { config.SMTP = smtpConfig })
+	config.SMTP = tSMTPConfig{Host: "127.0.0.1", Port: port, From: "gs@localhost", To: []string{"ops@localhost"}, StderrLines: 2, RateLimit: 3600}
+	task := &pb.Task{Uuid: "alert-task", Name: "alertTask", AlertEmails: []string{"owner@localhost"}}
+	addTestTask(t, task)
+	run := runs.create(task, "cron", "")
+	runs.appendStderr(run, []byte("line1\nline2\nline3\n"))
+	event := func() *pb.TaskLog {
+		return &pb.TaskLog{Uuid: task.GetUuid(), Name: task.GetName(), RunId: run.GetRunId(), Attempt: 1, Type: "error",
+			Message: "exit status 1", Timestamp: time.Now().UnixMicro(),
+			Result: &pb.RunResult{Reason: pb.RunReason_REASON_NON_ZERO_EXIT, ExitCode: 1}}
+	}
+	a := newAlerts()
+
+	a.notify(event())
+	a.notify(event()) // Suppressed by rate limit
+	var mail string
+	select {
+	case mail = <-mails:
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert not received")
+	}
+	for _, want := range []string{"To: ops@localhost, owner@localhost", "Subject: gScheduler: alertTask failed", "exit code: 1", "line2\r\nline3"} {
+		if !strings.Contains(mail, want) {
+			t.Errorf("mail does not contain %q:\n%s", want, mail)
+		}
+	}
+	if strings.Contains(mail, "line1") {
+		t.Errorf("mail contains more than %d stderr lines:\n%s", config.SMTP.StderrLines, mail)
+	}
+	select {
+	case mail = <-mails:
+		t.Fatalf("rate limited alert received:\n%s", mail)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	a.mutex.Lock()
+	a.lastSent[task.GetUuid()] = time.Now().Add(-time.Hour) // Rate limit elapsed
+	a.mutex.Unlock()
+	a.notify(event())
+	select {
+	case mail = <-mails:
+		if want := "Suppressed alerts since last email: 1"; !strings.Contains(mail, want) {
+			t.Errorf("mail does not contain %q:\n%s", want, mail)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert after rate limit not received")
+	}
+}
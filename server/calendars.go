@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Calendars (holidays, blackout windows) saved to calendars_file. Calendar contains whole days (dates) and
+// recurring windows (start schedule + duration). Task excludes scheduled runs which are in any of exclude_calendars
+// and (if include_calendars is set) runs which are not in any of include_calendars. Manual runs are not affected.
+// Missing calendar (e.g. calendars_file edited by hand) is logged as error and doesn't exclude any run.
+
+var calendarNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+type tCalendars struct {
+	mutex     sync.RWMutex
+	calendars map[string]*tCalendar
+	missing   sync.Map // Missing calendars already logged (name -> true)
+}
+
+type tCalendar struct {
+	calendar *pb.Calendar
+	loc      *time.Location
+	dates    map[string]bool
+	windows  []tCalendarWindow
+}
+
+type tCalendarWindow struct {
+	start    cron.Schedule
+	duration time.Duration
+}
+
+// Load calendars from file
+func (c *tCalendars) load() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	calendarsData, err := os.ReadFile(config.CalendarsFile)
+	if os.IsNotExist(err) {
+		return nil // Created with first calendar
+	}
+	if err != nil {
+		return fmt.Errorf("openFile: %s", err.Error())
+	}
+	list := make([]*pb.Calendar, 0)
+	if err := yaml.Unmarshal(calendarsData, &list); err != nil {
+		return fmt.Errorf("unmarshal: %s", err.Error())
+	}
+	for _, calendar := range list {
+		parsed, err := parseCalendar(calendar)
+		if err != nil {
+			return fmt.Errorf("calendar: %s, err: %s", calendar.GetName(), err.Error())
+		}
+		c.calendars[calendar.GetName()] = parsed
+	}
+	return nil
+}
+
+// Validate and parse calendar
+func parseCalendar(calendar *pb.Calendar) (*tCalendar, error) {
+	if !calendarNameRegexp.MatchString(calendar.GetName()) || len(calendar.GetName()) > 128 {
+		return nil, fmt.Errorf("errName-only[A-Za-z0-9_.-]max128chars")
+	}
+	if len(calendar.GetDescription()) > 256 {
+		return nil, fmt.Errorf("errDescription-max256chars")
+	}
+	timezone := calendar.GetTimezone()
+	if timezone == "" {
+		timezone = config.Timezone
+	}
+	parsed := &tCalendar{calendar: calendar, loc: time.Local, dates: make(map[string]bool)}
+	if timezone != "" {
+		var err error
+		if parsed.loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("errTimezone-%s", err.Error())
+		}
+	}
+	for _, date := range calendar.GetDates() {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, fmt.Errorf("errDates-%s", err.Error())
+		}
+		parsed.dates[date] = true
+	}
+	for _, window := range calendar.GetWindows() {
+		start, err := parseSchedule(window.GetStart(), calendar.GetTimezone())
+		if err != nil {
+			return nil, fmt.Errorf("errWindowStart-%s", err.Error())
+		}
+		if window.GetDuration() < 1 {
+			return nil, fmt.Errorf("errWindowDuration-min1sec")
+		}
+		parsed.windows = append(parsed.windows, tCalendarWindow{start: start, duration: time.Duration(window.GetDuration()) * time.Second})
+	}
+	return parsed, nil
+}
+
+// Create or update calendar
+func (c *tCalendars) set(calendar *pb.Calendar) error {
+	parsed, err := parseCalendar(calendar)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.calendars[calendar.GetName()] = parsed
+	c.missing.Delete(calendar.GetName())
+	return c.save()
+}
+
+// Delete calendar which is not used by any task. Lock order tasks -> calendars (same as tasks create/update
+// calling calendars.exists) so task can't start using calendar between check and delete
+func (c *tCalendars) delete(name string) error {
+	tasks.mutex.RLock()
+	defer tasks.mutex.RUnlock()
+	for _, task := range tasks.tasks {
+		for _, used := range append(task.GetExcludeCalendars(), task.GetIncludeCalendars()...) {
+			if used == name {
+				return fmt.Errorf("calendarUsedByTask: %s", task.GetUuid())
+			}
+		}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.calendars[name]; !ok {
+		return fmt.Errorf("calendarNotFound")
+	}
+	delete(c.calendars, name)
+	return c.save()
+}
+
+func (c *tCalendars) exists(name string) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	_, ok := c.calendars[name]
+	return ok
+}
+
+// Return all calendars sorted by name
+func (c *tCalendars) list() []*pb.Calendar {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	list := make([]*pb.Calendar, 0, len(c.calendars))
+	for _, calendar := range c.calendars {
+		list = append(list, calendar.calendar)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].GetName() < list[j].GetName() })
+	return list
+}
+
+// Check if scheduled run of task is excluded by its calendars. Returns reason (calendar name or "notIncluded")
+// Missing calendars fail open (run is not excluded)
+func (c *tCalendars) excluded(task *pb.Task, t time.Time) (string, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for _, name := range task.GetExcludeCalendars() {
+		calendar := c.calendars[name]
+		if calendar == nil {
+			c.logMissing(name, task)
+			continue
+		}
+		if calendar.contains(t) {
+			return name, true
+		}
+	}
+	if len(task.GetIncludeCalendars()) == 0 {
+		return "", false
+	}
+	for _, name := range task.GetIncludeCalendars() {
+		calendar := c.calendars[name]
+		if calendar == nil {
+			c.logMissing(name, task)
+			return "", false
+		}
+		if calendar.contains(t) {
+			return "", false
+		}
+	}
+	return "notIncluded", true
+}
+
+// Log missing calendar once (excluded is called for every checked run)
+func (c *tCalendars) logMissing(name string, task *pb.Task) {
+	if _, logged := c.missing.LoadOrStore(name, true); !logged {
+		logger.Errorf("calendarNotFound: %s, task: %s (runs are not excluded by missing calendar)", name, task.GetUuid())
+	}
+}
+
+// Check if time is in calendar dates or windows
+func (cal *tCalendar) contains(t time.Time) bool {
+	if cal.dates[t.In(cal.loc).Format("2006-01-02")] {
+		return true
+	}
+	for _, window := range cal.windows {
+		// Window contains t if it started within last duration
+		if start := window.start.Next(t.Add(-window.duration)); !start.IsZero() && !start.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Save calendars to file. Call with mutex locked
+func (c *tCalendars) save() error {
+	list := make([]*pb.Calendar, 0, len(c.calendars))
+	for _, calendar := range c.calendars {
+		list = append(list, calendar.calendar)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].GetName() < list[j].GetName() })
+	calendarsData, err := yaml.Marshal(list)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(config.CalendarsFile), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(config.CalendarsFile, calendarsData, 0644)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestCalendarsExcludedMissing(t *testing.T) {
+	holidays, err := parseCalendar(&pb.Calendar{Name: "holidays", Timezone: "UTC", Dates: []string{"2024-12-25"}})
+	if err != nil {
+		t.Fatalf("parseCalendar: %s", err.Error())
+	}
+	c := &tCalendars{calendars: map[string]*tCalendar{"holidays": holidays}}
+	christmas := time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC)
+	workday := time.Date(2024, 12, 27, 10, 0, 0, 0, time.UTC)
+	logged := testLogger.count("calendarNotFound: removed")
+	for _, test := range []struct {
+		task     *pb.Task
+		t        time.Time
+		excluded bool
+	}{
+		{&pb.Task{ExcludeCalendars: []string{"holidays"}}, christmas, true},
+		{&pb.Task{ExcludeCalendars: []string{"holidays"}}, workday, false},
+		{&pb.Task{ExcludeCalendars: []string{"removed"}}, workday, false}, // Missing calendar fails open
+		{&pb.Task{ExcludeCalendars: []string{"removed", "holidays"}}, christmas, true},
+		{&pb.Task{IncludeCalendars: []string{"holidays"}}, workday, true},
+		{&pb.Task{IncludeCalendars: []string{"removed"}}, workday, false},
+	} {
+		if _, excluded := c.excluded(test.task, test.t); excluded != test.excluded {
+			t.Errorf("%v %v at %s: excluded: %v, want: %v", test.task.GetExcludeCalendars(), test.task.GetIncludeCalendars(), test.t, excluded, test.excluded)
+		}
+	}
+	if count := testLogger.count("calendarNotFound: removed") - logged; count != 1 {
+		t.Errorf("missing calendar logged %d times, want: 1", count)
+	}
+}
@@ -9,18 +9,36 @@ import (
 
 type (
 	tConfig struct {
-		ServerAddress string `yaml:"server_address"`
-		ServerPort    string `yaml:"server_port"`
-		TasksFile     string `yaml:"tasks_file"`
-		LogFolder     string `yaml:"log_folder"`
-		LogLimit      int    `yaml:"log_limit"`
-		SSL           struct {
+		ServerAddress     string `yaml:"server_address"`
+		ServerPort        string `yaml:"server_port"`
+		TasksFile         string `yaml:"tasks_file"`
+		LogFolder         string `yaml:"log_folder"`
+		LogLimit          int    `yaml:"log_limit"`
+		RunsFile          string `yaml:"runs_file"`
+		RunLimit          int    `yaml:"run_limit"`
+		MaxConcurrentRuns int    `yaml:"max_concurrent_runs"` // Max running processes of all tasks (0 = unlimited)
+		FireTimesFile     string `yaml:"fire_times_file"`     // Last scheduled fire time of tasks (misfire catch-up)
+		MisfireGrace      int64  `yaml:"misfire_grace"`       // Default grace window of misfire catch-up in seconds
+		Timezone          string `yaml:"timezone"`            // Default timezone of task schedules (empty = server local time)
+		SecretsFile       string `yaml:"secrets_file"`
+		CalendarsFile     string `yaml:"calendars_file"` // Calendars of task exclusions (default calendars.yaml next to tasks_file)
+		CgroupRoot        string `yaml:"cgroup_root"`    // Parent cgroup (v2) of runs with resource limits
+		SecretsKey        string `yaml:"secrets_key"`
+		SSL               struct {
 			CRT        string `yaml:"crt"`
 			KEY        string `yaml:"key"`
 			CA         string `yaml:"ca"`
 			ClientCert bool   `yaml:"client_cert"`
 		} `yaml:"ssl"`
-		Apps map[string]string `yaml:"apps"`
+		Apps            map[string]string            `yaml:"apps"`
+		AppEnv          map[string]map[string]string `yaml:"app_env"`            // Environment variables per app (app name -> variables)
+		AppLimits       map[string]int               `yaml:"app_limits"`         // Max running processes per app (app name -> limit)
+		AppResources    map[string]tResourceLimits   `yaml:"app_resources"`      // Resource limits of processes per app (app name -> limits)
+		AppRunAs        map[string]tRunAs            `yaml:"app_run_as"`         // OS user/group of processes per app (app name -> user/group)
+		TaskRunAsUsers  []string                     `yaml:"task_run_as_users"`  // Users which can be set by task run_as_user
+		TaskRunAsGroups []string                     `yaml:"task_run_as_groups"` // Groups which can be set by task run_as_group
+		Webhooks        tWebhooksConfig              `yaml:"webhooks"`
+		SMTP            tSMTPConfig                  `yaml:"smtp"`
 	}
 )
 
@@ -56,6 +74,10 @@ func (c *tConfig) delApp(name string) error {
 		logger.Errorf("configLoadFailed: %s", err.Error())
 	}
 	delete(c.Apps, name)
+	delete(c.AppEnv, name)
+	delete(c.AppLimits, name)
+	delete(c.AppResources, name)
+	delete(c.AppRunAs, name)
 	configData, err := yaml.Marshal(c)
 	if err != nil {
 		return err
@@ -74,6 +96,41 @@ func (c *tConfig) fixConfigPaths() {
 	if !filepath.IsAbs(c.TasksFile) {
 		c.TasksFile = filepath.Join(filepath.Dir(os.Args[0]), c.TasksFile)
 	}
+	if config.RunsFile == "" {
+		c.RunsFile = filepath.Join(filepath.Dir(c.TasksFile), "runs.yaml")
+	}
+	c.RunsFile = filepath.FromSlash(os.ExpandEnv(c.RunsFile))
+	if !filepath.IsAbs(c.RunsFile) {
+		c.RunsFile = filepath.Join(filepath.Dir(os.Args[0]), c.RunsFile)
+	}
+	if config.FireTimesFile == "" {
+		c.FireTimesFile = filepath.Join(filepath.Dir(c.TasksFile), "fire_times.yaml")
+	}
+	c.FireTimesFile = filepath.FromSlash(os.ExpandEnv(c.FireTimesFile))
+	if !filepath.IsAbs(c.FireTimesFile) {
+		c.FireTimesFile = filepath.Join(filepath.Dir(os.Args[0]), c.FireTimesFile)
+	}
+	if config.CalendarsFile == "" {
+		c.CalendarsFile = filepath.Join(filepath.Dir(c.TasksFile), "calendars.yaml")
+	}
+	c.CalendarsFile = filepath.FromSlash(os.ExpandEnv(c.CalendarsFile))
+	if !filepath.IsAbs(c.CalendarsFile) {
+		c.CalendarsFile = filepath.Join(filepath.Dir(os.Args[0]), c.CalendarsFile)
+	}
+	if config.SecretsFile == "" {
+		c.SecretsFile = filepath.Join(filepath.Dir(c.TasksFile), "secrets.yaml")
+	}
+	c.SecretsFile = filepath.FromSlash(os.ExpandEnv(c.SecretsFile))
+	if !filepath.IsAbs(c.SecretsFile) {
+		c.SecretsFile = filepath.Join(filepath.Dir(os.Args[0]), c.SecretsFile)
+	}
+	if config.SecretsKey == "" {
+		c.SecretsKey = filepath.Join(filepath.Dir(os.Args[0]), "secrets.key")
+	}
+	c.SecretsKey = filepath.FromSlash(os.ExpandEnv(c.SecretsKey))
+	if !filepath.IsAbs(c.SecretsKey) {
+		c.SecretsKey = filepath.Join(filepath.Dir(os.Args[0]), c.SecretsKey)
+	}
 	c.LogFolder = filepath.FromSlash(os.ExpandEnv(c.LogFolder))
 	if !filepath.IsAbs(c.LogFolder) {
 		c.LogFolder = filepath.Join(filepath.Dir(os.Args[0]), c.LogFolder)
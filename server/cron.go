@@ -3,11 +3,18 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"fmt"
 	"io"
+	"math/big"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	pb "github.com/mmalcek/gscheduler/proto/go"
@@ -15,8 +22,27 @@ import (
 )
 
 type tCron struct {
-	cron    *cron.Cron
-	running bool
+	cron        *cron.Cron
+	running     bool
+	mutex       sync.Mutex    // Pause state and stopped
+	state       string        // Pause state: empty (not paused), paused, draining
+	since       time.Time     // Time of last state change
+	dispatching int64         // Runs being dispatched (jitter, catch-up, hooks, workflow) not yet counted by tasksCTX
+	stopped     chan struct{} // Closed when scheduler is stopped (interrupts jitter delay)
+}
+
+type tRunOptions struct {
+	trigger       string    // cron, taskRun, catchUp, nextTask, workflow, onFailure, onFinish
+	workflowID    string    // Workflow instance of run (empty = run is root of new instance)
+	env           []string  // Additional environment variables (KEY=value)
+	scheduledTime time.Time // Time when run was scheduled (zero = now)
+	dispatched    func()    // Called when run got slot or is queued (may be called more than once)
+}
+
+func (opts tRunOptions) dispatch() {
+	if opts.dispatched != nil {
+		opts.dispatched()
+	}
 }
 
 // Rebuild all tasks and start scheduler
@@ -33,11 +59,112 @@ func (cr *tCron) start() error {
 		}
 	}
 	tasks.saveTasksMutex() // Save tasks to file to update cronID
+	cr.mutex.Lock()
+	cr.stopped = make(chan struct{})
+	cr.mutex.Unlock()
 	cr.cron.Start()
 	cr.running = true
+	cr.setState("")
+	cr.afterDowntime()
+	return nil
+}
+
+// Catch up runs missed while scheduler was stopped/paused and expire tasks which passed their window meanwhile
+func (cr *tCron) afterDowntime() {
+	cr.catchUp() // Run missed runs (misfire policy)
+	for _, task := range tasks.getAll() {
+		go cr.expire(task)
+	}
+}
+
+// Pause scheduled runs. Running runs finish, tasks.yaml is not changed (pause is not kept after service restart)
+func (cr *tCron) pause() error {
+	if !cr.running {
+		return fmt.Errorf("schedulerNotRunning")
+	}
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	if cr.state != "" {
+		return fmt.Errorf("schedulerAlreadyPaused")
+	}
+	cr.state, cr.since = "paused", time.Now()
+	taskLog <- &pb.TaskLog{Name: "scheduler", Message: "schedulerPaused", Type: "sys", Timestamp: time.Now().UnixMicro()}
 	return nil
 }
 
+// Resume paused scheduler. Runs missed while paused are handled by task misfire policy
+func (cr *tCron) resume() error {
+	cr.mutex.Lock()
+	if cr.state == "" {
+		cr.mutex.Unlock()
+		return fmt.Errorf("schedulerNotPaused")
+	}
+	cr.state, cr.since = "", time.Now()
+	cr.mutex.Unlock()
+	taskLog <- &pb.TaskLog{Name: "scheduler", Message: "schedulerResumed", Type: "sys", Timestamp: time.Now().UnixMicro()}
+	cr.afterDowntime()
+	return nil
+}
+
+// Pause scheduler and wait until no run is in progress (or ctx is done)
+func (cr *tCron) drain(ctx context.Context) (*pb.SchedulerState, error) {
+	if !cr.paused() {
+		if err := cr.pause(); err != nil {
+			return nil, err
+		}
+	}
+	cr.setState("draining")
+	for {
+		state := cr.status()
+		if state.GetRunning() == 0 && state.GetQueued() == 0 && atomic.LoadInt64(&cr.dispatching) == 0 {
+			cr.setState("paused")
+			taskLog <- &pb.TaskLog{Name: "scheduler", Message: "schedulerQuiescent", Type: "sys", Timestamp: time.Now().UnixMicro()}
+			return cr.status(), nil
+		}
+		select {
+		case <-ctx.Done(): // Drain canceled, scheduler stays paused (unless resumed meanwhile)
+			cr.mutex.Lock()
+			if cr.state == "draining" {
+				cr.state, cr.since = "paused", time.Now()
+			}
+			cr.mutex.Unlock()
+			return cr.status(), ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+func (cr *tCron) setState(state string) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	cr.state, cr.since = state, time.Now()
+}
+
+// Scheduled and automatic runs (catch-up, hooks, workflow, nextTask) are not started while paused or draining
+func (cr *tCron) paused() bool {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	return cr.state != ""
+}
+
+// Return state of scheduler and number of runs in progress
+func (cr *tCron) status() *pb.SchedulerState {
+	cr.mutex.Lock()
+	state := &pb.SchedulerState{State: cr.state, Since: cr.since.UnixMicro()}
+	cr.mutex.Unlock()
+	switch {
+	case !cr.running:
+		state.State = "stopped"
+	case state.State == "":
+		state.State = "running"
+	}
+	for _, count := range tasksCTX.counts() {
+		state.Running += count[0]
+		state.Queued += count[1]
+	}
+	return state
+}
+
 // Remove all tasks and stop task scheduler (force=cancell all contexts immediately)
 func (cr *tCron) stop(force bool) error {
 	if !cr.running {
@@ -45,10 +172,15 @@ func (cr *tCron) stop(force bool) error {
 	}
 	if force {
 		for key := range tasksCTX.getAll() { // Force kill all tasks
-			tasksCTX.get(key).cancel()
+			tasksCTX.stop(key)
 		}
 	}
 	cr.removeAll()
+	cr.mutex.Lock()
+	if cr.stopped != nil {
+		close(cr.stopped) // Jobs waiting for jitter delay return, cron.Stop waits for running jobs
+	}
+	cr.mutex.Unlock()
 	<-cr.cron.Stop().Done()
 	cr.running = false
 	tasks.resetCronID()
@@ -73,104 +205,388 @@ func (cr *tCron) addTask(task *pb.Task) (cronid int64, err error) {
 		return 0, nil // Task is disabled
 	}
 	// fmt.Println("Adding task:", task.GetName())
-	id, err := cr.cron.AddFunc(task.GetSchedule(), cr.taskJob(task))
+	schedule, err := taskSchedule(task) // Same parser as validateInput
 	if err != nil {
 		return 0, err
 	}
-	return int64(id), nil
+	return int64(cr.cron.Schedule(schedule, cron.FuncJob(cr.taskJob(task, "cron")))), nil
 }
 
-func (cr *tCron) taskJob(task *pb.Task) func() {
-	return func() {
-		// If context exists - task is already running
-		if tasksCTX.get(task.GetUuid()) != nil {
-			taskLog <- genMsg(task, "alreadyRunning", "error")
-			return
-		}
-		// Create context for task - this allows call cancel context and also detect if task is currently running
-		tasksCTX.add(task.GetUuid(), task.GetTimeout())
-		defer tasksCTX.cancel(task.GetUuid())
+// Return next and previous run of scheduled task from cron entry (zero if task is not scheduled)
+func (cr *tCron) entryRuns(task *pb.Task) (next time.Time, prev time.Time) {
+	if task.GetCronId() == 0 {
+		return time.Time{}, time.Time{}
+	}
+	entry := cr.cron.Entry(cron.EntryID(task.GetCronId()))
+	if !entry.Valid() {
+		return time.Time{}, time.Time{}
+	}
+	prev = entry.Prev
+	if prev.IsZero() { // Not fired since scheduler start
+		prev = fireTimes.get(task.GetUuid())
+	}
+	return entry.Next, prev
+}
 
-		// Run task with context
-		cmd := exec.CommandContext(tasksCTX.get(task.GetUuid()).ctx, config.Apps[task.GetApp()], task.GetArgs()...)
-		cmd.Dir = filepath.Dir(config.Apps[task.GetApp()]) // Set working directory to app path
-		if task.GetWorkDir() != "" {                       // If working directory is set - use it
-			cmd.Dir = task.GetWorkDir()
-		}
-		stdoutIn, err := cmd.StdoutPipe()
-		if err != nil {
-			taskLog <- genMsg(task, fmt.Sprintf("stdoutPipe: %v", err.Error()), "error")
-			return
-		}
-		stderrIn, err := cmd.StderrPipe()
-		if err != nil {
-			taskLog <- genMsg(task, fmt.Sprintf("stderrPipe: %v", err.Error()), "error")
-			return
+// Return next count run times of task (or schedule if task is nil). Scheduled task starts from its cron entry, runs excluded by calendars are skipped
+func (cr *tCron) nextRuns(task *pb.Task, spec string, timezone string, count int64) ([]int64, error) {
+	var schedule cron.Schedule
+	var err error
+	runs := make([]int64, 0, count)
+	next := time.Now()
+	if task != nil {
+		if schedule, err = taskSchedule(task); err != nil {
+			return nil, err
 		}
-		if err := cmd.Start(); err != nil {
-			taskLog <- genMsg(task, fmt.Sprintf("cmdStart: %v", err.Error()), "error")
-			return
+		if entryNext, _ := cr.entryRuns(task); !entryNext.IsZero() {
+			next = entryNext
+			if _, excluded := calendars.excluded(task, next); !excluded {
+				runs = append(runs, next.UnixMicro())
+			}
 		}
-		taskLog <- genMsg(task, "started", "info")
-
-		// Read stdout and stderr - and wait for task finish
-		var errStdout, errStderr error
-		var wg sync.WaitGroup
-		wg.Add(1)
-		go func() {
-			errStdout = parseStdErrOut(stdoutIn, task, "stdout")
-			wg.Done()
-		}()
-		errStderr = parseStdErrOut(stderrIn, task, "stderr")
-		wg.Wait()
-
-		if errStdout != nil {
-			taskLog <- genMsg(task, fmt.Sprintf("stdOutParse: %v", errStdout.Error()), "error")
-			return
+	} else {
+		if spec, err = hashSchedule(spec, ""); err != nil { // Hash of schedule without task
+			return nil, err
 		}
-		if errStderr != nil {
-			taskLog <- genMsg(task, fmt.Sprintf("stdErrParse: %v", errStderr.Error()), "error")
-			return
+		if schedule, err = parseSchedule(spec, timezone); err != nil {
+			return nil, err
 		}
-		if tasksCTX.get(task.GetUuid()).ctx.Err() != nil { // Check if context was cancelled (e.g. timeout)
-			taskLog <- genMsg(task, fmt.Sprintf("taskContext: %s", tasksCTX.get(task.GetUuid()).ctx.Err().Error()), "error")
-			return
+	}
+	for i := 0; int64(len(runs)) < count && i < 100000; i++ { // Limit runs checked (calendars can exclude all of them)
+		if next = schedule.Next(next); next.IsZero() {
+			break // Schedule has no more runs
 		}
-		taskFinishOK := false // Run next task only if previous task finished OK
-		if err = cmd.Wait(); err != nil {
-			taskLog <- genMsg(task, err.Error(), "exitStatus") // Maybe replace err.(*exec.ExitError).ExitCode()
-		} else {
-			taskFinishOK = true
-			taskLog <- genMsg(task, "exit status 0", "exitStatus")
+		if task != nil {
+			if _, excluded := calendars.excluded(task, next); excluded {
+				continue
+			}
 		}
+		runs = append(runs, next.UnixMicro())
+	}
+	return runs, nil
+}
 
-		// If next task is set validate and run it
-		if taskFinishOK && task.GetNextTask() != "" {
-			nextTask := tasks.get(task.GetNextTask())
-			if nextTask == nil {
-				taskLog <- genMsg(task, "nextTaskNotFound", "error")
+func (cr *tCron) taskJob(task *pb.Task, trigger string) func() {
+	return func() {
+		cr.dispatch(1) // Before pause check, so drain waits for run which passed it
+		defer cr.dispatch(-1)
+		scheduledTime := time.Now().Truncate(time.Second)
+		if trigger == "cron" {
+			if cr.paused() { // Fire time is not saved, run is handled by misfire policy when scheduler is resumed
+				taskLog <- genSkippedMsg(task, "skippedByPause")
 				return
 			}
-			if nextTask.GetEnabled() {
-				taskLog <- genMsg(task, "nextTaskEnabled", "error") // nextTask must be disabled from schedule
+			defer cr.expire(task) // One-shot or bounded schedule may have no more runs
+			fireTimes.set(task.GetUuid(), scheduledTime)
+			if calendar, excluded := calendars.excluded(task, scheduledTime); excluded {
+				taskLog <- genSkippedMsg(task, fmt.Sprintf("skippedByCalendar: %s", calendar))
 				return
 			}
-			taskLog <- genMsg(task, "done", "info")
-			cr.taskJob(nextTask)() // Main task will finish (defer tasksCTX.cancel(task.GetUuid())) once "nextTask" is done
+			if task.GetJitter() > 0 { // Random delay before run (doesn't count to timeout and concurrency)
+				delay := jitterDelay(task.GetJitter())
+				taskLog <- genMsg(task, nil, fmt.Sprintf("jitter: delay %s", delay), "info")
+				if !cr.wait(delay) {
+					taskLog <- genSkippedMsg(task, "jitter: scheduler stopped")
+					return
+				}
+				current := tasks.getEnabled(task.GetUuid()) // Task could be stopped, updated or deleted meanwhile
+				if current == nil || cr.paused() {
+					taskLog <- genSkippedMsg(task, "jitter: task or scheduler stopped")
+					return
+				}
+				task = current
+			}
+		}
+		cr.runTask(task, tRunOptions{trigger: trigger, scheduledTime: scheduledTime})
+	}
+}
+
+// Wait for delay, returns false if scheduler was stopped meanwhile
+func (cr *tCron) wait(delay time.Duration) bool {
+	cr.mutex.Lock()
+	stopped := cr.stopped
+	cr.mutex.Unlock()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-stopped:
+		return false
+	}
+}
+
+// Count runs being dispatched (drain waits for them)
+func (cr *tCron) dispatch(delta int64) {
+	atomic.AddInt64(&cr.dispatching, delta)
+}
+
+// Random delay between 0 and jitter seconds
+func jitterDelay(jitter int64) time.Duration {
+	n, err := rand.Int(rand.Reader, big.NewInt(jitter*1000+1))
+	if err != nil {
+		return 0
+	}
+	return time.Duration(n.Int64()) * time.Millisecond
+}
+
+// Disable (or delete if expire_action is "delete") enabled task which has no more scheduled runs
+func (cr *tCron) expire(task *pb.Task) {
+	if !task.GetEnabled() {
+		return
+	}
+	schedule, err := taskSchedule(task)
+	if err != nil || !schedule.Next(time.Now()).IsZero() {
+		return
+	}
+	if err := tasks.stop(task.GetUuid(), false); err != nil { // Wait for running runs of task
+		taskLog <- genMsg(task, nil, fmt.Sprintf("expireStop: %s", err.Error()), "error")
+		return
+	}
+	action := "disabled"
+	if task.GetExpireAction() == "delete" {
+		if err := tasks.delete(task.GetUuid()); err != nil {
+			taskLog <- genMsg(task, nil, fmt.Sprintf("expireDelete: %s", err.Error()), "error")
+		} else {
+			action = "deleted"
+		}
+	}
+	taskLog <- genMsg(task, nil, fmt.Sprintf("taskExpired: %s", action), "sys")
+}
+
+// Run task (including retries, workflow, hooks and nextTask)
+func (cr *tCron) runTask(task *pb.Task, opts tRunOptions) {
+	defer opts.dispatch() // Run skipped or finished
+	run := runs.create(task, opts.trigger, opts.workflowID)
+	if opts.trigger != "taskRun" && cr.paused() { // Only manual runs are started while paused
+		result := &pb.RunResult{ExitCode: -1, Reason: pb.RunReason_REASON_SKIPPED}
+		taskLog <- genResultMsg(task, run, "skippedByPause", "info", result)
+		runs.finish(run, result)
+		workflows.finished(task, run, result)
+		return
+	}
+	// Create context for task (or wait for slot) according to concurrency policy - this allows call cancel context and also detect if task is currently running
+	action, wait := tasksCTX.acquire(task.GetUuid(), task.GetConcurrencyPolicy(), task.GetConcurrencyLimit())
+	if action != "start" {
+		taskLog <- genMsg(task, run, fmt.Sprintf("concurrency: %s", action), "info")
+	}
+	if wait != nil {
+		runs.setState(run, "queued")
+		opts.dispatch()
+		if !<-wait { // Dropped (replaced by newer run or task stopped)
+			taskLog <- genMsg(task, run, "concurrency: dropped", "info")
+			action = "skip"
+		}
+		runs.setState(run, "running")
+	}
+	if action == "skip" {
+		result := &pb.RunResult{ExitCode: -1, Reason: pb.RunReason_REASON_SKIPPED}
+		taskLog <- genResultMsg(task, run, "alreadyRunning", "error", result)
+		runs.finish(run, result)
+		workflows.finished(task, run, result)
+		return
+	}
+	defer tasksCTX.release(task.GetUuid())
+	taskCtx := tasksCTX.get(task.GetUuid()).ctx
+
+	// Run task and retry it according to retry policy
+	var result *pb.RunResult
+	for attempt := int64(1); ; attempt++ {
+		runs.setAttempt(run, attempt)
+		var resultMsg *pb.TaskLog
+		result, resultMsg = cr.runAttempt(task, run, taskCtx, opts)
+		delay, retry := retryDelay(task.GetRetry(), result, attempt)
+		if !retry {
+			taskLog <- resultMsg
+			break
+		}
+		resultMsg.Result = nil // Only one result per run (webhooks, alerts)
+		taskLog <- resultMsg
+		taskLog <- genMsg(task, run, fmt.Sprintf("retry: attempt %d/%d in %s", attempt+1, task.GetRetry().GetMaxAttempts(), delay), "info")
+		select {
+		case <-time.After(delay):
+			continue
+		case <-taskCtx.Done(): // Task force stopped while waiting for retry
+			result = &pb.RunResult{ExitCode: result.GetExitCode(), DurationMs: result.GetDurationMs(), Reason: pb.RunReason_REASON_CANCELLED}
+			taskLog <- genResultMsg(task, run, "retryCancelled", "error", result)
+		}
+		break
+	}
+	runs.finish(run, result)
+	workflows.finished(task, run, result)                          // Start dependent tasks (if any)
+	if opts.trigger != "onFailure" && opts.trigger != "onFinish" { // Hooks are not triggered by hook runs (avoid loops)
+		cr.runHooks(task, run, result)
+	}
+
+	// If next task is set validate and run it (only if task finished OK)
+	if result.GetReason() == pb.RunReason_REASON_SUCCESS && task.GetNextTask() != "" {
+		nextTask := tasks.get(task.GetNextTask())
+		if nextTask == nil {
+			taskLog <- genMsg(task, run, "nextTaskNotFound", "error")
+			return
+		}
+		if nextTask.GetEnabled() {
+			taskLog <- genMsg(task, run, "nextTaskEnabled", "error") // nextTask must be disabled from schedule
 			return
 		}
-		taskLog <- genMsg(task, "done", "info")
+		taskLog <- genMsg(task, run, "done", "info")
+		cr.runTask(nextTask, tRunOptions{trigger: "nextTask"}) // Main task will finish (defer tasksCTX.release(task.GetUuid())) once "nextTask" is done
+		return
+	}
+	taskLog <- genMsg(task, run, "done", "info")
+}
+
+// Run single attempt of task. Timeout is applied to each attempt separately.
+// Returns result and its message, message is sent by runTask (result is attached only to final attempt)
+func (cr *tCron) runAttempt(task *pb.Task, run *pb.Run, taskCtx context.Context, opts tRunOptions) (*pb.RunResult, *pb.TaskLog) {
+	// Wait for free slot (max_concurrent_runs, app_limits). Waiting doesn't count to timeout
+	err := dispatcher.acquire(taskCtx, task, run, task.GetPriority(), func(position int) {
+		runs.setState(run, "queued")
+		taskLog <- genMsg(task, run, fmt.Sprintf("queued: position %d", position), "info")
+		opts.dispatch()
+	})
+	opts.dispatch()
+	runs.setState(run, "running")
+	if err != nil {
+		result := &pb.RunResult{ExitCode: -1, Reason: pb.RunReason_REASON_CANCELLED}
+		return result, genResultMsg(task, run, err.Error(), "error", result)
+	}
+	defer dispatcher.release(task.GetApp())
+	startTime := time.Now()
+	ctx, cancel := context.WithTimeout(taskCtx, time.Duration(task.GetTimeout())*time.Second)
+	defer cancel()
+	failedToStart := func(msg string) (*pb.RunResult, *pb.TaskLog) {
+		result := genResult(nil, ctx, startTime)
+		result.ExitCode, result.Reason = -1, pb.RunReason_REASON_FAILED_TO_START
+		return result, genResultMsg(task, run, msg, "error", result)
+	}
+	rendered, err := renderTask(task, templateData(task, run, opts))
+	if err != nil {
+		return failedToStart(fmt.Sprintf("template: %s", err.Error()))
+	}
+	// Resolve secrets right before execution, values are redacted from output
+	args, argSecrets, err := secrets.resolve(rendered.args)
+	if err != nil {
+		return failedToStart(err.Error())
+	}
+	env, envSecrets, err := secrets.resolve(taskEnv(task, rendered.env, run, opts))
+	if err != nil {
+		return failedToStart(err.Error())
+	}
+	redact := append(argSecrets, envSecrets...)
+	cmd := exec.CommandContext(ctx, config.Apps[task.GetApp()], args...)
+	cmd.Dir = filepath.Dir(config.Apps[task.GetApp()]) // Set working directory to app path
+	if rendered.workDir != "" {                        // If working directory is set - use it
+		cmd.Dir = rendered.workDir
+	}
+	cmd.Env = env
+	if rendered.stdin != "" {
+		cmd.Stdin = strings.NewReader(rendered.stdin)
+	}
+	if err := setRunAs(cmd, task); err != nil {
+		return failedToStart(err.Error())
+	}
+	finished := setStopSignal(cmd, task, run) // Graceful stop on timeout/cancel
+	defer finished()
+	limits := prepareLimits(cmd, task, run) // Resource limits (cgroup of run)
+	defer limits.cleanup()
+	stdoutIn, err := cmd.StdoutPipe()
+	if err != nil {
+		return failedToStart(fmt.Sprintf("stdoutPipe: %v", err.Error()))
+	}
+	stderrIn, err := cmd.StderrPipe()
+	if err != nil {
+		return failedToStart(fmt.Sprintf("stderrPipe: %v", err.Error()))
+	}
+	if err := cmd.Start(); err != nil {
+		return failedToStart(fmt.Sprintf("cmdStart: %v", err.Error()))
+	}
+	limits.started(cmd, task, run)
+	taskLog <- genMsg(task, run, "started", "info")
+
+	// Read stdout and stderr - and wait for task finish
+	var errStdout, errStderr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		errStdout = parseStdErrOut(stdoutIn, task, run, "stdout", redact)
+		wg.Done()
+	}()
+	errStderr = parseStdErrOut(stderrIn, task, run, "stderr", redact)
+	wg.Wait()
+
+	if errStdout != nil {
+		taskLog <- genMsg(task, run, fmt.Sprintf("stdOutParse: %v", errStdout.Error()), "error")
+	}
+	if errStderr != nil {
+		taskLog <- genMsg(task, run, fmt.Sprintf("stdErrParse: %v", errStderr.Error()), "error")
+	}
+	err = cmd.Wait()
+	if ctx.Err() != nil { // Check if context was cancelled (e.g. timeout)
+		taskLog <- genMsg(task, run, fmt.Sprintf("taskContext: %s", ctx.Err().Error()), "error")
+	}
+	result := genResult(err, ctx, startTime)
+	limits.finished(task, run, result)
+	exitStatus := "exit status 0"
+	if err != nil {
+		exitStatus = err.Error()
+	}
+	return result, genResultMsg(task, run, exitStatus, "exitStatus", result)
+}
+
+// Run onFailure/onFinish hook tasks. Hook receives metadata of finished run in environment variables
+func (cr *tCron) runHooks(task *pb.Task, run *pb.Run, result *pb.RunResult) {
+	if result.GetReason() == pb.RunReason_REASON_SKIPPED {
+		return // Task didn't run at all
+	}
+	env := []string{
+		"GSCHEDULER_PARENT_TASK_UUID=" + task.GetUuid(),
+		"GSCHEDULER_PARENT_TASK_NAME=" + task.GetName(),
+		"GSCHEDULER_PARENT_RUN_ID=" + run.GetRunId(),
+		"GSCHEDULER_PARENT_EXIT_CODE=" + strconv.FormatInt(result.GetExitCode(), 10),
+		"GSCHEDULER_PARENT_REASON=" + result.GetReason().String(),
+		"GSCHEDULER_PARENT_STDERR=" + runs.get(run.GetRunId()).GetStderrTail(),
+	}
+	hooks := map[string]string{"onFinish": task.GetOnFinishTask()}
+	if result.GetReason() != pb.RunReason_REASON_SUCCESS {
+		hooks["onFailure"] = task.GetOnFailureTask()
+	}
+	for trigger, hookUUID := range hooks {
+		if hookUUID == "" {
+			continue
+		}
+		hookTask := tasks.get(hookUUID)
+		if hookTask == nil {
+			taskLog <- genMsg(task, run, fmt.Sprintf("%sTaskNotFound", trigger), "error")
+			continue
+		}
+		cr.dispatch(1)
+		go func(hookTask *pb.Task, trigger string) {
+			defer cr.dispatch(-1)
+			cr.runTask(hookTask, tRunOptions{trigger: trigger, env: env})
+		}(hookTask, trigger)
 	}
 }
 
-func parseStdErrOut(r io.Reader, task *pb.Task, msgType string) error {
+func parseStdErrOut(r io.Reader, task *pb.Task, run *pb.Run, msgType string, redact []string) error {
 	buf := make([]byte, 2048)
+	redactor := &tRedactor{values: redact}
+	send := func(data []byte) {
+		if len(data) == 0 {
+			return
+		}
+		taskLog <- genMsg(task, run, string(data), msgType)
+		if msgType == "stderr" {
+			runs.appendStderr(run, data)
+		}
+	}
 	for {
 		n, err := r.Read(buf[:])
 		if n > 0 {
-			taskLog <- genMsg(task, string(buf[:n]), msgType)
+			send(redactor.write(buf[:n]))
 		}
 		if err != nil {
+			send(redactor.flush())
 			if err == io.EOF {
 				err = nil
 			}
@@ -186,6 +602,8 @@ func tasksLogWatch(event chan *pb.TaskLog) {
 		if err := taskLogToFile(data); err != nil {
 			logger.Errorf("Error writing to LOG: %v", err.Error())
 		}
+		webhooks.notify(data)
+		alerts.notify(data)
 		activeChans := logWatchChans.getAll()
 		for i := range activeChans {
 			activeChans[i] <- data
@@ -193,7 +611,7 @@ func tasksLogWatch(event chan *pb.TaskLog) {
 	}
 }
 
-func genMsg(task *pb.Task, msg string, msgType string) *pb.TaskLog {
+func genMsg(task *pb.Task, run *pb.Run, msg string, msgType string) *pb.TaskLog {
 	return &pb.TaskLog{
 		Name:      task.GetName(),
 		Tags:      task.GetTags(),
@@ -201,30 +619,90 @@ func genMsg(task *pb.Task, msg string, msgType string) *pb.TaskLog {
 		Message:   msg,
 		Type:      msgType,
 		Timestamp: time.Now().UnixMicro(),
+		RunId:     run.GetRunId(),
+		Attempt:   run.GetAttempts(),
 	}
 }
 
+func genResultMsg(task *pb.Task, run *pb.Run, msg string, msgType string, result *pb.RunResult) *pb.TaskLog {
+	taskLog := genMsg(task, run, msg, msgType)
+	taskLog.Result = result
+	return taskLog
+}
+
+// Message of scheduled run which was not started (calendar, pause). Result marks it as skipped event
+func genSkippedMsg(task *pb.Task, msg string) *pb.TaskLog {
+	return genResultMsg(task, nil, msg, "info", &pb.RunResult{ExitCode: -1, Reason: pb.RunReason_REASON_SKIPPED})
+}
+
+// Create run result from cmd.Wait() error and state of task context
+func genResult(err error, ctx context.Context, startTime time.Time) *pb.RunResult {
+	result := &pb.RunResult{DurationMs: time.Since(startTime).Milliseconds(), Reason: pb.RunReason_REASON_SUCCESS}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		result.ExitCode = int64(exitErr.ExitCode())
+		if ws, ok := exitErr.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+			result.Signal = ws.Signal().String()
+		}
+	} else if err != nil {
+		result.ExitCode = -1
+	}
+	switch {
+	case ctx.Err() == context.DeadlineExceeded:
+		result.Reason = pb.RunReason_REASON_TIMEOUT
+	case ctx.Err() == context.Canceled:
+		result.Reason = pb.RunReason_REASON_CANCELLED
+	case err != nil:
+		result.Reason = pb.RunReason_REASON_NON_ZERO_EXIT
+	}
+	return result
+}
+
 // Single command execution without schedule
 func execCommand(request *pb.Task) (*pb.ExecStatus, error) {
 	taskLog <- &pb.TaskLog{Name: "execCmd", Tags: request.GetTags(), Message: "started", Type: "info", Timestamp: time.Now().UnixMicro()}
 	var outb, errb bytes.Buffer
 	ctx, can := context.WithTimeout(context.Background(), time.Duration(request.GetTimeout())*time.Second)
 	defer can()
-	cmd := exec.CommandContext(ctx, config.Apps[request.GetApp()], request.GetArgs()...)
+	startTime := time.Now()
+	failedToStart := func(err error) (*pb.ExecStatus, error) {
+		result := &pb.RunResult{ExitCode: -1, DurationMs: time.Since(startTime).Milliseconds(), Reason: pb.RunReason_REASON_FAILED_TO_START}
+		taskLog <- &pb.TaskLog{Name: "execCmd", Tags: request.GetTags(), Message: err.Error(), Type: "error", Timestamp: time.Now().UnixMicro(), Result: result}
+		return &pb.ExecStatus{Stdout: "", Stderr: err.Error(), ExitCode: -1, Result: result}, err
+	}
+	rendered, err := renderTask(request, &tTemplateData{ScheduledTime: startTime, Trigger: "execCmd", Task: request, PrevRun: &pb.Run{Result: &pb.RunResult{}}})
+	if err != nil {
+		return failedToStart(fmt.Errorf("template: %s", err.Error()))
+	}
+	args, argSecrets, err := secrets.resolve(rendered.args)
+	if err != nil {
+		return failedToStart(err)
+	}
+	env, envSecrets, err := secrets.resolve(taskEnv(request, rendered.env, nil, tRunOptions{}))
+	if err != nil {
+		return failedToStart(err)
+	}
+	cmd := exec.CommandContext(ctx, config.Apps[request.GetApp()], args...)
 	cmd.Dir = filepath.Dir(config.Apps[request.GetApp()]) // Set working directory to app path
-	if request.GetWorkDir() != "" {                       // If working directory is set - use it
-		cmd.Dir = request.GetWorkDir()
+	if rendered.workDir != "" {                           // If working directory is set - use it
+		cmd.Dir = rendered.workDir
+	}
+	cmd.Env = env
+	if rendered.stdin != "" {
+		cmd.Stdin = strings.NewReader(rendered.stdin)
 	}
+	if err := setRunAs(cmd, request); err != nil {
+		return failedToStart(err)
+	}
+	setProcessGroup(cmd) // Timeout kills also children of process
+	cmd.Cancel = func() error { return signalProcess(cmd, os.Kill) }
 	cmd.Stdout = &outb
 	cmd.Stderr = &errb
 	if err := cmd.Start(); err != nil {
-		taskLog <- &pb.TaskLog{Name: "execCmd", Tags: request.GetTags(), Message: err.Error(), Type: "error", Timestamp: time.Now().UnixMicro()}
-		return &pb.ExecStatus{Stdout: "", Stderr: err.Error(), ExitCode: -1}, err
-	}
-	exitCode := 0
-	if err := cmd.Wait(); err != nil {
-		exitCode = err.(*exec.ExitError).ExitCode()
+		return failedToStart(err)
 	}
-	taskLog <- &pb.TaskLog{Name: "execCmd", Tags: request.GetTags(), Message: "done", Type: "info", Timestamp: time.Now().UnixMicro()}
-	return &pb.ExecStatus{Stdout: outb.String(), Stderr: errb.String(), ExitCode: int64(exitCode)}, nil
+	result := genResult(cmd.Wait(), ctx, startTime)
+	taskLog <- &pb.TaskLog{Name: "execCmd", Tags: request.GetTags(), Message: "done", Type: "info", Timestamp: time.Now().UnixMicro(), Result: result}
+	redact := append(argSecrets, envSecrets...)
+	stdout, stderr := redactSecrets(outb.Bytes(), redact), redactSecrets(errb.Bytes(), redact)
+	return &pb.ExecStatus{Stdout: string(stdout), Stderr: string(stderr), ExitCode: result.GetExitCode(), Result: result}, nil
 }
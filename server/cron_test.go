@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestExecCommandTemplates(t *testing.T) {
+	apps := config.Apps
+	config.Apps = map[string]string{"sh": "/bin/sh"}
+	defer func() { config.Apps = apps }()
+	request := &pb.Task{Name: "execTemplates", App: "sh", Timeout: 5, Template: true,
+		Args:  []string{"-c", "echo {{.Trigger}} $NAME; cat"},
+		Env:   map[string]string{"NAME": "{{.Task.Name}}"},
+		Stdin: "stdin of {{.Task.Name}}"}
+
+	status, err := execCommand(request)
+	if err != nil {
+		t.Fatalf("execCommand: %s", err.Error())
+	}
+	if want := "execCmd execTemplates\nstdin of execTemplates"; status.GetStdout() != want {
+		t.Errorf("stdout: %q, want: %q", status.GetStdout(), want)
+	}
+
+	request.Args = []string{"-c", "echo {{.Unknown}}"}
+	if status, err := execCommand(request); err == nil || status.GetResult().GetReason() != pb.RunReason_REASON_FAILED_TO_START {
+		t.Errorf("invalid template: %v, want: REASON_FAILED_TO_START", status.GetResult())
+	}
+
+	request.Template = false // Values are used as they are
+	request.Args = []string{"-c", "echo '{{.Names}}'"}
+	if status, err := execCommand(request); err != nil || status.GetStdout() != "{{.Names}}\n" {
+		t.Errorf("without template: %q (err: %v), want: {{.Names}}", status.GetStdout(), err)
+	}
+}
+
+func TestValidateTemplates(t *testing.T) {
+	for _, test := range []struct {
+		task  *pb.Task
+		valid bool
+	}{
+		{&pb.Task{Template: true, Args: []string{"--exit={{.PrevRun.Result.ExitCode}}"}}, true},
+		{&pb.Task{Template: true, Args: []string{"{{.Unknown}}"}}, false},
+		{&pb.Task{Template: true, Stdin: "{{"}, false},
+		{&pb.Task{Args: []string{"--format", "{{.Names}}"}}, true}, // Not rendered
+	} {
+		if err := validateTemplates(test.task); (err == nil) != test.valid {
+			t.Errorf("%v: err: %v, want valid: %v", test.task, err, test.valid)
+		}
+	}
+}
+
+func TestCronWaitInterruptedByStop(t *testing.T) {
+	cr := &tCron{stopped: make(chan struct{})}
+	if !cr.wait(time.Millisecond) {
+		t.Error("wait without stop returned false")
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(cr.stopped)
+	}()
+	start := time.Now()
+	if cr.wait(time.Hour) {
+		t.Error("wait interrupted by stop returned true")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("wait returned after %s", elapsed)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Dispatcher limits number of running processes (config max_concurrent_runs and app_limits).
+// Attempt over the limit waits in queue ordered by priority (higher first) and queue time.
+// Waiting doesn't count to task timeout, queued run can be cancelled (QueueCancel) or stopped with task.
+
+type tDispatcher struct {
+	mutex   sync.Mutex
+	total   int            // Running processes
+	running map[string]int // Running processes per app
+	queue   []*tQueuedRun  // Sorted by priority desc, seq asc
+	seq     int64          // Queue order of runs with same priority
+}
+
+type tQueuedRun struct {
+	run      *pb.QueuedRun
+	seq      int64
+	priority int64
+	ready    chan bool // true = slot acquired, false = cancelled
+}
+
+// Wait for free slot of app. Function queued is called (without dispatcher lock) if run has to wait. Returns error if run was cancelled while waiting
+func (d *tDispatcher) acquire(ctx context.Context, task *pb.Task, run *pb.Run, priority int64, queued func(position int)) error {
+	d.mutex.Lock()
+	if len(d.queue) == 0 && d.free(task.GetApp()) {
+		d.take(task.GetApp())
+		d.mutex.Unlock()
+		return nil
+	}
+	d.seq++
+	item := &tQueuedRun{
+		run: &pb.QueuedRun{
+			RunId:      run.GetRunId(),
+			TaskUuid:   task.GetUuid(),
+			Name:       task.GetName(),
+			App:        task.GetApp(),
+			Priority:   priority,
+			QueuedTime: time.Now().UnixMicro(),
+		},
+		seq:      d.seq,
+		priority: priority,
+		ready:    make(chan bool, 1),
+	}
+	position := len(d.queue)
+	for position > 0 && (d.queue[position-1].priority < priority) {
+		position--
+	}
+	d.queue = append(d.queue, nil)
+	copy(d.queue[position+1:], d.queue[position:])
+	d.queue[position] = item
+	d.dispatch() // Run can be started if only other apps are blocked
+	waiting := len(item.ready) == 0
+	d.mutex.Unlock()
+	if waiting { // Called without lock (callback sends to taskLog and can dispatch other runs)
+		queued(position + 1)
+	}
+	select {
+	case ok := <-item.ready:
+		if !ok {
+			return fmt.Errorf("queueCancelled")
+		}
+		return nil
+	case <-ctx.Done():
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		if !d.remove(item.run.GetRunId()) && <-item.ready { // Slot was acquired meanwhile
+			d.total--
+			d.running[task.GetApp()]--
+			d.dispatch()
+		}
+		return fmt.Errorf("queueCancelled: %s", ctx.Err().Error())
+	}
+}
+
+// Release slot of finished process and start waiting runs
+func (d *tDispatcher) release(app string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.total--
+	d.running[app]--
+	d.dispatch()
+}
+
+// Start queued runs in order while there are free slots. Call with mutex locked
+func (d *tDispatcher) dispatch() {
+	for i := 0; i < len(d.queue); {
+		if config.MaxConcurrentRuns > 0 && d.total >= config.MaxConcurrentRuns {
+			return
+		}
+		item := d.queue[i]
+		if !d.free(item.run.GetApp()) {
+			i++ // App limit reached, try runs of other apps
+			continue
+		}
+		d.take(item.run.GetApp())
+		d.queue = append(d.queue[:i], d.queue[i+1:]...)
+		item.ready <- true
+	}
+}
+
+// Check free slot for app. Call with mutex locked
+func (d *tDispatcher) free(app string) bool {
+	if config.MaxConcurrentRuns > 0 && d.total >= config.MaxConcurrentRuns {
+		return false
+	}
+	if limit := config.AppLimits[app]; limit > 0 && d.running[app] >= limit {
+		return false
+	}
+	return true
+}
+
+// Call with mutex locked
+func (d *tDispatcher) take(app string) {
+	d.total++
+	d.running[app]++
+}
+
+// Remove run from queue. Call with mutex locked
+func (d *tDispatcher) remove(runID string) bool {
+	for i := range d.queue {
+		if d.queue[i].run.GetRunId() == runID {
+			d.queue = append(d.queue[:i], d.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Cancel queued run
+func (d *tDispatcher) cancel(runID string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for i := range d.queue {
+		if d.queue[i].run.GetRunId() == runID {
+			d.queue[i].ready <- false
+			d.queue = append(d.queue[:i], d.queue[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("runNotQueued")
+}
+
+// List queued runs in order
+func (d *tDispatcher) list() *pb.Queue {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	queue := &pb.Queue{Runs: make([]*pb.QueuedRun, 0, len(d.queue))}
+	for i := range d.queue {
+		run := d.queue[i].run
+		queue.Runs = append(queue.Runs, &pb.QueuedRun{
+			RunId:      run.GetRunId(),
+			TaskUuid:   run.GetTaskUuid(),
+			Name:       run.GetName(),
+			App:        run.GetApp(),
+			Priority:   run.GetPriority(),
+			QueuedTime: run.GetQueuedTime(),
+			Position:   int64(i + 1),
+		})
+	}
+	return queue
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Environment of task process is merged in this order (later overrides earlier):
+//  1. service environment
+//  2. HOME, USER, LOGNAME of run_as user (if task runs as other user)
+//  3. app env (config.yaml app_env)
+//  4. task env (Task.Env, rendered templates)
+//  5. built-in variables GSCHEDULER_TASK_UUID, GSCHEDULER_TASK_NAME, GSCHEDULER_RUN_ID, GSCHEDULER_SCHEDULED_TIME (RFC3339)
+//  6. run variables (e.g. GSCHEDULER_PARENT_* of hook tasks)
+
+// Duplicate variables are resolved by exec.Cmd (last value is used)
+func taskEnv(task *pb.Task, taskVars map[string]string, run *pb.Run, opts tRunOptions) []string {
+	env := os.Environ()
+	env = append(env, runAsEnv(task)...)
+	env = append(env, sortedEnv(config.AppEnv[task.GetApp()])...)
+	env = append(env, sortedEnv(taskVars)...)
+	scheduledTime := opts.scheduledTime
+	if scheduledTime.IsZero() {
+		scheduledTime = time.Now()
+	}
+	env = append(env,
+		"GSCHEDULER_TASK_UUID="+task.GetUuid(),
+		"GSCHEDULER_TASK_NAME="+task.GetName(),
+		"GSCHEDULER_RUN_ID="+run.GetRunId(),
+		"GSCHEDULER_SCHEDULED_TIME="+scheduledTime.Format(time.RFC3339),
+	)
+	return append(env, opts.env...)
+}
+
+func sortedEnv(vars map[string]string) []string {
+	env := make([]string, 0, len(vars))
+	for k, v := range vars {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	return env
+}
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func validateEnv(env map[string]string) error {
+	for k := range env {
+		if !envNameRegexp.MatchString(k) {
+			return fmt.Errorf("errEnv-invalidName: %s", k)
+		}
+		if strings.HasPrefix(k, "GSCHEDULER_") {
+			return fmt.Errorf("errEnv-reservedName: %s", k)
+		}
+	}
+	return nil
+}
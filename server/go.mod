@@ -1,22 +1,22 @@
 module github.com/mmalcek/gscheduler/server
 
-go 1.19
+go 1.20
 
 require (
 	github.com/google/uuid v1.3.0
 	github.com/kardianos/service v1.2.1
 	github.com/mmalcek/gscheduler/proto/go v0.0.0-20220824111148-fba13ff9781b
 	github.com/robfig/cron/v3 v3.0.1
+	golang.org/x/sys v0.0.0-20220823224334-20c2bfdbfe24
 	golang.org/x/term v0.0.0-20220722155259-a9ba230a4035
 	google.golang.org/grpc v1.49.0
+	google.golang.org/protobuf v1.28.1
 	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/golang/protobuf v1.5.2 // indirect
 	golang.org/x/net v0.0.0-20220822230855-b0a4917ee28c // indirect
-	golang.org/x/sys v0.0.0-20220823224334-20c2bfdbfe24 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20220822174746-9e6da59bd2fc // indirect
-	google.golang.org/protobuf v1.28.1 // indirect
 )
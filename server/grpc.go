@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/google/uuid"
 	pb "github.com/mmalcek/gscheduler/proto/go"
@@ -15,6 +16,7 @@ import (
 	codes "google.golang.org/grpc/codes" // https://grpc.github.io/grpc/core/md_doc_statuscodes.html
 	"google.golang.org/grpc/credentials"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type server struct {
@@ -130,7 +132,18 @@ func (s *server) TaskStart(ctx context.Context, in *pb.TaskUUID) (*pb.Status, er
 
 // List all tasks
 func (s *server) TasksList(ctx context.Context, in *pb.Empty) (*pb.Tasks, error) {
-	return &pb.Tasks{Tasks: tasks.getAll()}, nil
+	list := &pb.Tasks{Tasks: make([]*pb.Task, 0)}
+	for _, task := range tasks.getAll() {
+		task = proto.Clone(task).(*pb.Task)
+		if next, prev := scheduler.entryRuns(task); !next.IsZero() {
+			task.NextRun = next.UnixMicro()
+			if !prev.IsZero() {
+				task.PrevRun = prev.UnixMicro()
+			}
+		}
+		list.Tasks = append(list.Tasks, task)
+	}
+	return list, nil
 }
 
 // Run task once/immediately (Runs in go routine - caller can watch status in SchedulerWatch)
@@ -139,11 +152,12 @@ func (s *server) TaskRun(ctx context.Context, in *pb.TaskUUID) (*pb.Status, erro
 	if task == nil {
 		return &pb.Status{Message: "failed", Uuid: in.GetUuid()}, status.Newf(codes.InvalidArgument, "notFound").Err()
 	}
-	if tasksCTX.get(in.GetUuid()).ctx != nil {
+	policy := task.GetConcurrencyPolicy()
+	if (policy == "" || policy == "skip") && tasksCTX.get(in.GetUuid()) != nil {
 		return &pb.Status{Message: "failed", Uuid: in.GetUuid()}, status.Newf(codes.FailedPrecondition, "alreadyRunning").Err()
 	}
 	go func() {
-		scheduler.taskJob(task)()
+		scheduler.taskJob(task, "taskRun")()
 	}()
 	return &pb.Status{Message: "success", Uuid: in.GetUuid()}, nil
 }
@@ -164,6 +178,35 @@ func (s *server) SchedulerStart(ctx context.Context, in *pb.Empty) (*pb.Status,
 	return &pb.Status{Message: "success"}, nil
 }
 
+// Pause scheduled runs (running runs finish)
+func (s *server) SchedulerPause(ctx context.Context, in *pb.Empty) (*pb.Status, error) {
+	if err := scheduler.pause(); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// Resume paused scheduler
+func (s *server) SchedulerResume(ctx context.Context, in *pb.Empty) (*pb.Status, error) {
+	if err := scheduler.resume(); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// Pause scheduler and wait until it's quiescent (no run in progress). Scheduler stays paused after drain
+func (s *server) SchedulerDrain(ctx context.Context, in *pb.Empty) (*pb.SchedulerState, error) {
+	state, err := scheduler.drain(ctx)
+	if err != nil {
+		return state, status.Newf(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return state, nil
+}
+
+func (s *server) SchedulerStatus(ctx context.Context, in *pb.Empty) (*pb.SchedulerState, error) {
+	return scheduler.status(), nil
+}
+
 // Watch all tasks events as they happen (stream)
 func (s *server) SchedulerWatch(in *pb.Empty, stream pb.TaskManager_SchedulerWatchServer) error {
 	chanUUID := uuid.New().String()
@@ -181,13 +224,24 @@ func (s *server) SchedulerWatch(in *pb.Empty, stream pb.TaskManager_SchedulerWat
 	}
 }
 
-// Return UUIDs of currently running tasks
-func (s *server) SchedulerRunningTasks(ctx context.Context, in *pb.Empty) (*pb.List, error) {
-	tsk := make([]string, 0)
-	for key := range tasksCTX.getAll() { // If channel exists task is running
-		tsk = append(tsk, key)
+// Return UUIDs of currently running tasks (details sorted by priority)
+func (s *server) SchedulerRunningTasks(ctx context.Context, in *pb.Empty) (*pb.RunningTasks, error) {
+	running := &pb.RunningTasks{Data: make([]string, 0), Tasks: make([]*pb.RunningTask, 0)}
+	for key, count := range tasksCTX.counts() { // If context exists task is running
+		running.Data = append(running.Data, key)
+		task := tasks.get(key)
+		running.Tasks = append(running.Tasks, &pb.RunningTask{
+			Uuid:     key,
+			Name:     task.GetName(),
+			Priority: task.GetPriority(),
+			Running:  count[0],
+			Queued:   count[1],
+		})
 	}
-	return &pb.List{Data: tsk}, nil
+	sort.SliceStable(running.Tasks, func(i, j int) bool {
+		return running.Tasks[i].GetPriority() > running.Tasks[j].GetPriority()
+	})
+	return running, nil
 }
 
 // Exec single command without using scheduler
@@ -208,3 +262,93 @@ func (s *server) LogGet(ctx context.Context, in *pb.Request) (*pb.File, error) {
 	}
 	return &pb.File{Content: file}, nil
 }
+
+// List task runs history (newest first). Empty TaskUuid returns runs of all tasks
+func (s *server) RunList(ctx context.Context, in *pb.RunFilter) (*pb.Runs, error) {
+	return runs.list(in.GetTaskUuid(), in.GetOffset(), in.GetLimit()), nil
+}
+
+// Get single run by run ID
+func (s *server) RunGet(ctx context.Context, in *pb.RunID) (*pb.Run, error) {
+	run := runs.get(in.GetRunId())
+	if run == nil {
+		return nil, status.Newf(codes.NotFound, "runNotFound").Err()
+	}
+	return run, nil
+}
+
+// Preview next run times of schedule (or existing task)
+func (s *server) ScheduleNextRuns(ctx context.Context, in *pb.ScheduleRequest) (*pb.ScheduleRuns, error) {
+	count := in.GetCount()
+	if count < 1 {
+		count = 10
+	}
+	if count > 1000 {
+		count = 1000
+	}
+	var task *pb.Task
+	if in.GetTaskUuid() != "" {
+		if task = tasks.get(in.GetTaskUuid()); task == nil {
+			return nil, status.Newf(codes.NotFound, "taskNotFound").Err()
+		}
+	}
+	runs, err := scheduler.nextRuns(task, in.GetSchedule(), in.GetTimezone(), count)
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, fmt.Sprintf("errSchedule-%s", err.Error())).Err()
+	}
+	return &pb.ScheduleRuns{Runs: runs}, nil
+}
+
+// List runs waiting for free slot (max_concurrent_runs, app_limits)
+func (s *server) QueueList(ctx context.Context, in *pb.Empty) (*pb.Queue, error) {
+	return dispatcher.list(), nil
+}
+
+// Cancel queued run
+func (s *server) QueueCancel(ctx context.Context, in *pb.RunID) (*pb.Status, error) {
+	if err := dispatcher.cancel(in.GetRunId()); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.NotFound, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// Create or update secret
+func (s *server) SecretSet(ctx context.Context, in *pb.Secret) (*pb.Status, error) {
+	if err := secrets.set(in.GetName(), in.GetValue()); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.Unknown, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// Delete secret
+func (s *server) SecretDelete(ctx context.Context, in *pb.SecretName) (*pb.Status, error) {
+	if err := secrets.delete(in.GetName()); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.NotFound, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// List secret names. Values are never returned
+func (s *server) SecretList(ctx context.Context, in *pb.Empty) (*pb.List, error) {
+	return &pb.List{Data: secrets.names()}, nil
+}
+
+// Create or update calendar
+func (s *server) CalendarSet(ctx context.Context, in *pb.Calendar) (*pb.Status, error) {
+	if err := calendars.set(in); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.InvalidArgument, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+// Delete calendar. Calendar used by any task can't be deleted
+func (s *server) CalendarDelete(ctx context.Context, in *pb.CalendarName) (*pb.Status, error) {
+	if err := calendars.delete(in.GetName()); err != nil {
+		return &pb.Status{Message: "failed"}, status.Newf(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return &pb.Status{Message: "success"}, nil
+}
+
+func (s *server) CalendarList(ctx context.Context, in *pb.Empty) (*pb.Calendars, error) {
+	return &pb.Calendars{Calendars: calendars.list()}, nil
+}
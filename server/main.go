@@ -24,6 +24,7 @@ type (
 var logger service.Logger
 
 func main() {
+	execLimitsHelper() // Started by scheduler to apply resource limits before exec of task app
 	flags := tFlags{}
 	flags.svc = flag.String("service", "", "Control the system service (start, stop, install, uninstall)")
 	flags.genCrt = flag.String("gencrt", "", "Generate SSL certificates")
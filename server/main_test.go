@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Logger which keeps messages in memory (service logger is not available in tests)
+type tTestLogger struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (l *tTestLogger) add(level string, msg string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.messages = append(l.messages, level+": "+msg)
+	return nil
+}
+
+func (l *tTestLogger) Error(v ...interface{}) error   { return l.add("error", fmt.Sprint(v...)) }
+func (l *tTestLogger) Warning(v ...interface{}) error { return l.add("warning", fmt.Sprint(v...)) }
+func (l *tTestLogger) Info(v ...interface{}) error    { return l.add("info", fmt.Sprint(v...)) }
+func (l *tTestLogger) Errorf(format string, a ...interface{}) error {
+	return l.add("error", fmt.Sprintf(format, a...))
+}
+func (l *tTestLogger) Warningf(format string, a ...interface{}) error {
+	return l.add("warning", fmt.Sprintf(format, a...))
+}
+func (l *tTestLogger) Infof(format string, a ...interface{}) error {
+	return l.add("info", fmt.Sprintf(format, a...))
+}
+
+// Count messages containing substring
+func (l *tTestLogger) count(substr string) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	count := 0
+	for _, msg := range l.messages {
+		if strings.Contains(msg, substr) {
+			count++
+		}
+	}
+	return count
+}
+
+var testLogger = &tTestLogger{}
+
+// Add task to tasks (without validation and tasks file), task is removed after test
+func addTestTask(t *testing.T, task *pb.Task) {
+	tasks.mutex.Lock()
+	defer tasks.mutex.Unlock()
+	tasks.tasks = append(tasks.tasks, task)
+	t.Cleanup(func() {
+		tasks.mutex.Lock()
+		defer tasks.mutex.Unlock()
+		for i := range tasks.tasks {
+			if tasks.tasks[i] == task {
+				tasks.tasks = append(tasks.tasks[:i], tasks.tasks[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+func TestMain(m *testing.M) {
+	execLimitsHelper() // Test binary is limits helper of tasks started in tests
+	logger = testLogger
+	go func() { // Task events are not processed in tests
+		for range taskLog {
+		}
+	}()
+	os.Exit(m.Run())
+}
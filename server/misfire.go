@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Misfire catch-up. Last scheduled fire time of each task is saved to fire_times_file (task UUID -> unix seconds).
+// When scheduler starts, runs missed since last fire time (within grace window) are handled by task misfire_policy.
+// Fire time of stopped task is removed so time when task was disabled is never caught up.
+// Fire times are written to file every FIRE_TIMES_SAVE_INTERVAL (if changed) and on service stop.
+// Tasks are caught up in order of priority (higher first), next task starts once first run of previous task got slot or is queued.
+
+const FIRE_TIMES_SAVE_INTERVAL = 5 * time.Second
+
+type tFireTimes struct {
+	mutex     sync.Mutex
+	times     map[string]int64
+	dirty     bool       // Changed since last save
+	saveMutex sync.Mutex // Serializes file writes
+}
+
+type tMissedRuns struct {
+	task   *pb.Task
+	missed []time.Time
+}
+
+// Load fire times from file
+func (f *tFireTimes) load() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	data, err := os.ReadFile(config.FireTimesFile)
+	if os.IsNotExist(err) {
+		return nil // Created with first fire
+	}
+	if err != nil {
+		return fmt.Errorf("openFile: %v", err.Error())
+	}
+	if err := yaml.Unmarshal(data, &f.times); err != nil {
+		return fmt.Errorf("unmarshal: %v", err.Error())
+	}
+	if f.times == nil {
+		f.times = make(map[string]int64)
+	}
+	return nil
+}
+
+func (f *tFireTimes) get(taskUUID string) time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.times[taskUUID] == 0 {
+		return time.Time{}
+	}
+	return time.Unix(f.times[taskUUID], 0)
+}
+
+// Set fire time of task (only if newer than saved one)
+func (f *tFireTimes) set(taskUUID string, fireTime time.Time) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if fireTime.Unix() <= f.times[taskUUID] {
+		return
+	}
+	f.times[taskUUID] = fireTime.Unix()
+	f.dirty = true
+}
+
+func (f *tFireTimes) delete(taskUUID string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if _, ok := f.times[taskUUID]; ok {
+		delete(f.times, taskUUID)
+		f.dirty = true
+	}
+}
+
+// Save changed fire times periodically
+func (f *tFireTimes) saver() {
+	for range time.Tick(FIRE_TIMES_SAVE_INTERVAL) {
+		f.save()
+	}
+}
+
+// Save fire times to file if changed. Failure is only logged (worst case missed runs are not caught up)
+func (f *tFireTimes) save() {
+	f.saveMutex.Lock()
+	defer f.saveMutex.Unlock()
+	f.mutex.Lock()
+	if !f.dirty {
+		f.mutex.Unlock()
+		return
+	}
+	data, err := yaml.Marshal(f.times)
+	f.dirty = false
+	f.mutex.Unlock()
+	if err != nil {
+		logger.Errorf("fireTimesMarshal: %s", err.Error())
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(config.FireTimesFile), os.ModePerm); err != nil {
+		logger.Errorf("fireTimesDir: %s", err.Error())
+		return
+	}
+	if err := os.WriteFile(config.FireTimesFile, data, 0644); err != nil {
+		logger.Errorf("fireTimesWrite: %s", err.Error())
+	}
+}
+
+// Run missed runs of all enabled tasks according to their misfire policy
+func (cr *tCron) catchUp() {
+	now := time.Now()
+	list := make([]tMissedRuns, 0)
+	for _, task := range tasks.getAll() {
+		if !task.GetEnabled() || task.GetMisfirePolicy() == "" || task.GetMisfirePolicy() == "ignore" {
+			continue
+		}
+		lastFire := fireTimes.get(task.GetUuid())
+		if lastFire.IsZero() {
+			continue // Task was never fired by scheduler
+		}
+		schedule, err := taskSchedule(task)
+		if err != nil {
+			continue
+		}
+		grace := time.Duration(task.GetMisfireGrace()) * time.Second
+		if grace <= 0 {
+			grace = time.Duration(config.MisfireGrace) * time.Second
+		}
+		limit := task.GetMisfireLimit()
+		if limit < 1 {
+			limit = 10
+		}
+		if task.GetMisfirePolicy() == "run_once" {
+			limit = 1
+		}
+		missed := missedRuns(schedule, lastFire, now, grace, limit)
+		if len(missed) == 0 {
+			continue
+		}
+		list = append(list, tMissedRuns{task: task, missed: missed})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].task.GetPriority() > list[j].task.GetPriority() })
+	cr.dispatch(int64(len(list))) // Released by runMissed
+	go func() {
+		for i := range list {
+			dispatched := make(chan struct{})
+			var once sync.Once
+			go cr.runMissed(list[i].task, list[i].missed, func() { once.Do(func() { close(dispatched) }) })
+			<-dispatched
+		}
+	}()
+}
+
+// Run missed runs of task one after another. dispatched is called once first run got slot or is queued
+func (cr *tCron) runMissed(task *pb.Task, missed []time.Time, dispatched func()) {
+	defer cr.dispatch(-1)
+	defer dispatched() // All runs skipped
+	for _, scheduledTime := range missed {
+		if cr.paused() { // Fire time is not saved, remaining runs are caught up when scheduler is resumed
+			taskLog <- genSkippedMsg(task, "skippedByPause")
+			return
+		}
+		fireTimes.set(task.GetUuid(), scheduledTime)
+		if calendar, excluded := calendars.excluded(task, scheduledTime); excluded {
+			taskLog <- genSkippedMsg(task, fmt.Sprintf("skippedByCalendar: %s", calendar))
+			continue
+		}
+		taskLog <- genMsg(task, nil, fmt.Sprintf("catchUp: missed run %s", scheduledTime.Format(time.RFC3339)), "info")
+		cr.runTask(task, tRunOptions{trigger: "catchUp", scheduledTime: scheduledTime, dispatched: dispatched})
+	}
+}
+
+// Return last (max limit) fire times of schedule after lastFire and before now, not older than grace window
+func missedRuns(schedule cron.Schedule, lastFire, now time.Time, grace time.Duration, limit int64) []time.Time {
+	from := lastFire
+	if grace > 0 && now.Add(-grace).After(from) {
+		from = now.Add(-grace).Add(-time.Second) // Next() returns time after "from"
+	}
+	missed := make([]time.Time, 0)
+	for t := schedule.Next(from); !t.IsZero() && t.Before(now); t = schedule.Next(t) {
+		if missed = append(missed, t); int64(len(missed)) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
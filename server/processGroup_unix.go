@@ -0,0 +1,32 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// Start process in its own process group so signals reach also its children (e.g. processes started by shell script)
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// Send signal to process group of cmd (process started by setProcessGroup)
+func signalProcess(cmd *exec.Cmd, sig os.Signal) error {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return cmd.Process.Signal(sig)
+	}
+	if err := syscall.Kill(-cmd.Process.Pid, s); err != nil {
+		if err == syscall.ESRCH {
+			return os.ErrProcessDone // Whole group already finished
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Process is gone when it doesn't exist or is zombie (not reaped by init in container)
+func processGone(pid int) bool {
+	if err := syscall.Kill(pid, 0); err == syscall.ESRCH {
+		return true
+	}
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return os.IsNotExist(err)
+	}
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) > 0 && fields[0] == "Z"
+}
+
+func TestProcessGroupKilledOnTimeout(t *testing.T) {
+	apps := config.Apps
+	config.Apps = map[string]string{"sh": "/bin/sh"}
+	defer func() { config.Apps = apps }()
+	pidFile := filepath.Join(t.TempDir(), "grandchild.pid")
+	// Grandchild inherits stdout, so output pipe is open until whole group is killed
+	task := &pb.Task{Uuid: "process-group", Name: "processGroup", App: "sh", Timeout: 1,
+		Args: []string{"-c", "sleep 60 & echo $! > " + pidFile + "; wait"}}
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.runTask(task, tRunOptions{trigger: "taskRun"})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("runTask did not return after timeout (waiting for output of grandchild)")
+	}
+
+	list := runs.list(task.GetUuid(), 0, 1)
+	if len(list.GetRuns()) != 1 || list.GetRuns()[0].GetResult().GetReason() != pb.RunReason_REASON_TIMEOUT {
+		t.Errorf("run: %v, want reason: REASON_TIMEOUT", list.GetRuns())
+	}
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		t.Fatalf("grandchild pid: %s", err.Error())
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		t.Fatalf("grandchild pid: %s", err.Error())
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for !processGone(pid) {
+		if time.Now().After(deadline) {
+			syscall.Kill(pid, syscall.SIGKILL)
+			t.Fatalf("grandchild %d is still running", pid)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build windows
+
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Process groups are not used on Windows (only direct child is stopped)
+func setProcessGroup(cmd *exec.Cmd) {}
+
+func signalProcess(cmd *exec.Cmd, sig os.Signal) error {
+	return cmd.Process.Signal(sig)
+}
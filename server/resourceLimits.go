@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Resource limits of task processes (Linux only). Limits are set per app in config app_resources and per task
+// (task value overrides app value if not 0). When cgroup v2 is available each run gets its own cgroup under
+// cgroup_root (memory, cpu, pids). Otherwise rlimits are used (memory = address space, processes = per user)
+// and CPU quota/weight are not applied. Open files and nice are always set by rlimit/setpriority.
+// Rlimits and nice are set before exec of app by gscheduler binary started as limits helper
+// (gscheduler __limits ...), so with run_as the binary must be executable by run_as user.
+
+type tResourceLimits struct {
+	MemoryMB   int64 `yaml:"memory_mb"`
+	CPUPercent int64 `yaml:"cpu_percent"`
+	CPUWeight  int64 `yaml:"cpu_weight"`
+	OpenFiles  int64 `yaml:"open_files"`
+	Processes  int64 `yaml:"processes"`
+	Nice       int64 `yaml:"nice"`
+}
+
+// Limits of task merged with limits of its app. Returns nil if there are no limits
+func taskLimits(task *pb.Task) *pb.ResourceLimits {
+	app := config.AppResources[task.GetApp()]
+	limits := &pb.ResourceLimits{
+		MemoryMb:   app.MemoryMB,
+		CpuPercent: app.CPUPercent,
+		CpuWeight:  app.CPUWeight,
+		OpenFiles:  app.OpenFiles,
+		Processes:  app.Processes,
+		Nice:       app.Nice,
+	}
+	if t := task.GetLimits(); t != nil {
+		for _, v := range []struct {
+			dst *int64
+			src int64
+		}{
+			{&limits.MemoryMb, t.GetMemoryMb()},
+			{&limits.CpuPercent, t.GetCpuPercent()},
+			{&limits.CpuWeight, t.GetCpuWeight()},
+			{&limits.OpenFiles, t.GetOpenFiles()},
+			{&limits.Processes, t.GetProcesses()},
+			{&limits.Nice, t.GetNice()},
+		} {
+			if v.src != 0 {
+				*v.dst = v.src
+			}
+		}
+	}
+	if limits.MemoryMb == 0 && limits.CpuPercent == 0 && limits.CpuWeight == 0 && limits.OpenFiles == 0 &&
+		limits.Processes == 0 && limits.Nice == 0 {
+		return nil
+	}
+	return limits
+}
+
+func validateLimits(limits *pb.ResourceLimits) error {
+	if limits == nil {
+		return nil
+	}
+	if limits.GetMemoryMb() < 0 || limits.GetCpuPercent() < 0 || limits.GetOpenFiles() < 0 || limits.GetProcesses() < 0 {
+		return fmt.Errorf("errLimits-negativeValue")
+	}
+	if limits.GetCpuWeight() < 0 || limits.GetCpuWeight() > 10000 {
+		return fmt.Errorf("errLimits-cpuWeight-1-10000")
+	}
+	if limits.GetNice() < -20 || limits.GetNice() > 19 {
+		return fmt.Errorf("errLimits-nice-20-19")
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+//go:build linux
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+	"golang.org/x/sys/unix"
+)
+
+// First argument of gscheduler started as limits helper: gscheduler __limits <resource=value,...> <app> <args...>
+const LIMITS_HELPER_ARG = "__limits"
+
+var (
+	cgroupOnce  sync.Once
+	cgroupError error // cgroup v2 not available (rlimit fallback)
+)
+
+// Rlimits which can be set by limits helper
+var helperRlimits = map[string]int{"nofile": unix.RLIMIT_NOFILE, "as": unix.RLIMIT_AS, "nproc": unix.RLIMIT_NPROC}
+
+type tRunLimits struct {
+	limits   *pb.ResourceLimits
+	cgroup   string // Cgroup of run (empty = rlimit fallback)
+	cgroupFD int    // Opened cgroup for cmd.SysProcAttr.CgroupFD (-1 = closed)
+}
+
+// Create cgroup_root and enable controllers (once). Error means cgroup v2 can't be used
+func cgroupSetup() error {
+	cgroupOnce.Do(func() {
+		if _, err := os.Stat("/sys/fs/cgroup/cgroup.controllers"); err != nil {
+			cgroupError = fmt.Errorf("cgroupV2NotAvailable")
+			return
+		}
+		if err := os.MkdirAll(config.CgroupRoot, 0755); err != nil {
+			cgroupError = err
+			return
+		}
+		for _, dir := range []string{filepath.Dir(config.CgroupRoot), config.CgroupRoot} {
+			if err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+memory +cpu +pids"), 0644); err != nil {
+				cgroupError = fmt.Errorf("cgroupControllers: %s", err.Error())
+				return
+			}
+		}
+	})
+	return cgroupError
+}
+
+// Prepare limits of run before cmd.Start. Cgroup of run is created and set to cmd, rlimits and nice
+// are set by limits helper (cmd is changed to start app by helper). Returns nil if task has no limits
+func prepareLimits(cmd *exec.Cmd, task *pb.Task, run *pb.Run) *tRunLimits {
+	limits := taskLimits(task)
+	if limits == nil {
+		return nil
+	}
+	l := &tRunLimits{limits: limits, cgroupFD: -1}
+	if limits.GetMemoryMb() > 0 || limits.GetCpuPercent() > 0 || limits.GetCpuWeight() > 0 || limits.GetProcesses() > 0 {
+		l.setCgroup(cmd, task, run)
+	}
+	helperLimits := make([]string, 0)
+	if limits.GetOpenFiles() > 0 {
+		helperLimits = append(helperLimits, fmt.Sprintf("nofile=%d", limits.GetOpenFiles()))
+	}
+	if l.cgroup == "" { // Fallback of cgroup limits
+		if limits.GetMemoryMb() > 0 {
+			helperLimits = append(helperLimits, fmt.Sprintf("as=%d", limits.GetMemoryMb()*1024*1024))
+		}
+		if limits.GetProcesses() > 0 {
+			helperLimits = append(helperLimits, fmt.Sprintf("nproc=%d", limits.GetProcesses()))
+		}
+		if limits.GetCpuPercent() > 0 || limits.GetCpuWeight() > 0 {
+			taskLog <- genMsg(task, run, "limits: cpu limits require cgroup v2", "error")
+		}
+	}
+	if limits.GetNice() != 0 {
+		helperLimits = append(helperLimits, fmt.Sprintf("nice=%d", limits.GetNice()))
+	}
+	if len(helperLimits) == 0 {
+		return l
+	}
+	helper, err := os.Executable()
+	if err != nil {
+		taskLog <- genMsg(task, run, fmt.Sprintf("limits: helper: %s", err.Error()), "error")
+		return l
+	}
+	cmd.Args = append([]string{helper, LIMITS_HELPER_ARG, strings.Join(helperLimits, ","), cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = helper
+	return l
+}
+
+// Create cgroup of run and start cmd in it. Rlimits are used as fallback if cgroup can't be used
+func (l *tRunLimits) setCgroup(cmd *exec.Cmd, task *pb.Task, run *pb.Run) {
+	if err := cgroupSetup(); err != nil {
+		taskLog <- genMsg(task, run, fmt.Sprintf("limits: rlimit fallback (%s)", err.Error()), "info")
+		return
+	}
+	l.cgroup = filepath.Join(config.CgroupRoot, fmt.Sprintf("%s-%d", run.GetRunId(), run.GetAttempts()))
+	if err := l.createCgroup(); err != nil {
+		taskLog <- genMsg(task, run, fmt.Sprintf("limits: rlimit fallback (%s)", err.Error()), "info")
+		os.Remove(l.cgroup)
+		l.cgroup = ""
+		return
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true // Process starts directly in cgroup of run
+	cmd.SysProcAttr.CgroupFD = l.cgroupFD
+}
+
+// Run as limits helper if started so (never returns). Sets rlimits and nice of own process and executes app,
+// so limits apply from start of app to all its threads and children. Errors are written to stderr of task
+func execLimitsHelper() {
+	if len(os.Args) < 4 || os.Args[1] != LIMITS_HELPER_ARG {
+		return
+	}
+	runtime.LockOSThread() // Nice is set per thread, exec is called from same thread
+	for _, limit := range strings.Split(os.Args[2], ",") {
+		name, value, _ := strings.Cut(limit, "=")
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "limits: %s: %s\n", name, err.Error())
+			continue
+		}
+		if name == "nice" {
+			err = unix.Setpriority(unix.PRIO_PROCESS, 0, int(number))
+		} else if resource, ok := helperRlimits[name]; ok {
+			err = unix.Setrlimit(resource, &unix.Rlimit{Cur: uint64(number), Max: uint64(number)})
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "limits: %s: %s\n", name, err.Error())
+		}
+	}
+	err := syscall.Exec(os.Args[3], os.Args[3:], os.Environ())
+	fmt.Fprintf(os.Stderr, "limits: exec: %s\n", err.Error())
+	os.Exit(127)
+}
+
+func (l *tRunLimits) createCgroup() error {
+	if err := os.Mkdir(l.cgroup, 0755); err != nil {
+		return err
+	}
+	files := make(map[string]string)
+	if l.limits.GetMemoryMb() > 0 {
+		files["memory.max"] = strconv.FormatInt(l.limits.GetMemoryMb()*1024*1024, 10)
+		files["memory.swap.max"] = "0"
+	}
+	if l.limits.GetCpuPercent() > 0 {
+		files["cpu.max"] = fmt.Sprintf("%d 100000", l.limits.GetCpuPercent()*1000)
+	}
+	if l.limits.GetCpuWeight() > 0 {
+		files["cpu.weight"] = strconv.FormatInt(l.limits.GetCpuWeight(), 10)
+	}
+	if l.limits.GetProcesses() > 0 {
+		files["pids.max"] = strconv.FormatInt(l.limits.GetProcesses(), 10)
+	}
+	for file, value := range files {
+		if err := os.WriteFile(filepath.Join(l.cgroup, file), []byte(value), 0644); err != nil && file != "memory.swap.max" {
+			return fmt.Errorf("%s: %s", file, err.Error())
+		}
+	}
+	fd, err := syscall.Open(l.cgroup, syscall.O_DIRECTORY|syscall.O_RDONLY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	l.cgroupFD = fd
+	return nil
+}
+
+// Close cgroup of run opened for cmd.Start
+func (l *tRunLimits) started(cmd *exec.Cmd, task *pb.Task, run *pb.Run) {
+	if l == nil {
+		return
+	}
+	l.closeFD()
+}
+
+// Check limit violations of finished process (cgroup only). OOM kill changes reason of result
+func (l *tRunLimits) finished(task *pb.Task, run *pb.Run, result *pb.RunResult) {
+	if l == nil || l.cgroup == "" {
+		return
+	}
+	if cgroupEvent(filepath.Join(l.cgroup, "memory.events"), "oom_kill") > 0 {
+		taskLog <- genMsg(task, run, "limits: oomKilled (memory limit reached)", "error")
+		if result.GetReason() != pb.RunReason_REASON_SUCCESS {
+			result.Reason = pb.RunReason_REASON_OOM_KILLED
+		}
+	}
+	if cgroupEvent(filepath.Join(l.cgroup, "pids.events"), "max") > 0 {
+		taskLog <- genMsg(task, run, "limits: processes limit reached", "error")
+	}
+}
+
+// Kill remaining processes of run and remove its cgroup
+func (l *tRunLimits) cleanup() {
+	if l == nil {
+		return
+	}
+	l.closeFD()
+	if l.cgroup == "" {
+		return
+	}
+	os.WriteFile(filepath.Join(l.cgroup, "cgroup.kill"), []byte("1"), 0644)
+	for i := 0; i < 10; i++ { // Cgroup can be removed once all processes exited
+		if err := os.Remove(l.cgroup); err == nil || os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	logger.Errorf("cgroupRemove: %s", l.cgroup)
+}
+
+func (l *tRunLimits) closeFD() {
+	if l.cgroupFD >= 0 {
+		syscall.Close(l.cgroupFD)
+		l.cgroupFD = -1
+	}
+}
+
+// Return value of key in cgroup events file (0 if not found)
+func cgroupEvent(file string, key string) int64 {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == key {
+			value, _ := strconv.ParseInt(fields[1], 10, 64)
+			return value
+		}
+	}
+	return 0
+}
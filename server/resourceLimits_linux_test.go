@@ -0,0 +1,31 @@
+//go:build linux
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestLimitsAppliedBeforeExec(t *testing.T) {
+	apps := config.Apps
+	config.Apps = map[string]string{"sh": "/bin/sh"}
+	defer func() { config.Apps = apps }()
+	outFile := filepath.Join(t.TempDir(), "limits.out")
+	task := &pb.Task{Uuid: "limits", Name: "limits", App: "sh", Timeout: 10,
+		Limits: &pb.ResourceLimits{OpenFiles: 64, Nice: 7},
+		Args:   []string{"-c", "echo $(ulimit -n) $(cut -d' ' -f19 /proc/$$/stat) > " + outFile}}
+
+	scheduler.runTask(task, tRunOptions{trigger: "taskRun"})
+	data, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("output: %s", err.Error())
+	}
+	if got := strings.TrimSpace(string(data)); got != "64 7" {
+		t.Errorf("open files and nice: %q, want: \"64 7\"", got)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+
+package main
+
+import (
+	"os/exec"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+type tRunLimits struct{}
+
+// Resource limits are supported only on Linux
+func prepareLimits(cmd *exec.Cmd, task *pb.Task, run *pb.Run) *tRunLimits {
+	if taskLimits(task) != nil {
+		taskLog <- genMsg(task, run, "limits: not supported on this platform", "error")
+	}
+	return nil
+}
+
+func execLimitsHelper() {}
+
+func (l *tRunLimits) started(cmd *exec.Cmd, task *pb.Task, run *pb.Run) {}
+
+func (l *tRunLimits) finished(task *pb.Task, run *pb.Run, result *pb.RunResult) {}
+
+func (l *tRunLimits) cleanup() {}
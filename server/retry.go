@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Return delay before next attempt and if task should be retried at all
+func retryDelay(policy *pb.RetryPolicy, result *pb.RunResult, attempt int64) (time.Duration, bool) {
+	if attempt >= policy.GetMaxAttempts() {
+		return 0, false
+	}
+	switch result.GetReason() {
+	case pb.RunReason_REASON_TIMEOUT:
+		if !policy.GetOnTimeout() {
+			return 0, false
+		}
+	case pb.RunReason_REASON_NON_ZERO_EXIT:
+		if len(policy.GetExitCodes()) > 0 {
+			retryCode := false
+			for _, code := range policy.GetExitCodes() {
+				if code == result.GetExitCode() {
+					retryCode = true
+					break
+				}
+			}
+			if !retryCode {
+				return 0, false
+			}
+		}
+	default: // success, cancelled, failed to start
+		return 0, false
+	}
+	delay := time.Duration(policy.GetDelay()) * time.Second
+	if policy.GetBackoff() == "exponential" {
+		for i := int64(1); i < attempt && delay < 24*time.Hour; i++ {
+			delay *= 2
+		}
+		if maxDelay := time.Duration(policy.GetMaxDelay()) * time.Second; maxDelay > 0 && delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+	if policy.GetJitter() > 0 {
+		delay += time.Duration(rand.Int63n(policy.GetJitter()*1000)) * time.Millisecond
+	}
+	return delay, true
+}
+
+func validateRetry(policy *pb.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.GetMaxAttempts() < 0 || policy.GetMaxAttempts() > 100 {
+		return fmt.Errorf("errRetry-maxAttempts0-100")
+	}
+	if policy.GetBackoff() != "" && policy.GetBackoff() != "fixed" && policy.GetBackoff() != "exponential" {
+		return fmt.Errorf("errRetry-backoff-fixed|exponential")
+	}
+	if policy.GetDelay() < 0 || policy.GetMaxDelay() < 0 || policy.GetJitter() < 0 {
+		return fmt.Errorf("errRetry-negativeDelay")
+	}
+	if policy.GetDelay() > 86400 || policy.GetMaxDelay() > 86400 || policy.GetJitter() > 86400 {
+		return fmt.Errorf("errRetry-delayMax86400sec")
+	}
+	return nil
+}
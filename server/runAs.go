@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Run process as OS user/group. Default user/group is set per app in config app_run_as, task can override it
+// only with users/groups listed in config task_run_as_users/task_run_as_groups. Group defaults to primary group
+// of user. Service must run as root (Windows is not supported).
+
+type tRunAs struct {
+	User  string `yaml:"user"`  // Name or uid
+	Group string `yaml:"group"` // Name or gid (empty = primary group of user)
+}
+
+// Return user and group of task process (empty = same as service)
+func taskRunAs(task *pb.Task) (string, string, error) {
+	if !allowedRunAs(task.GetRunAsUser(), config.TaskRunAsUsers) {
+		return "", "", fmt.Errorf("errRunAsUser-notAllowed: %s", task.GetRunAsUser())
+	}
+	if !allowedRunAs(task.GetRunAsGroup(), config.TaskRunAsGroups) {
+		return "", "", fmt.Errorf("errRunAsGroup-notAllowed: %s", task.GetRunAsGroup())
+	}
+	runAs := config.AppRunAs[task.GetApp()]
+	if task.GetRunAsUser() != "" {
+		runAs.User, runAs.Group = task.GetRunAsUser(), "" // Group of app user doesn't apply to other user
+	}
+	if task.GetRunAsGroup() != "" {
+		runAs.Group = task.GetRunAsGroup()
+	}
+	return runAs.User, runAs.Group, nil
+}
+
+func allowedRunAs(name string, allowed []string) bool {
+	if name == "" {
+		return true
+	}
+	for i := range allowed {
+		if allowed[i] == name {
+			return true
+		}
+	}
+	return false
+}
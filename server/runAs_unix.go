@@ -0,0 +1,95 @@
+//go:build !windows
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Set credential of task process (if task or its app has run_as)
+func setRunAs(cmd *exec.Cmd, task *pb.Task) error {
+	credential, err := runAsCredential(task)
+	if err != nil || credential == nil {
+		return err
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = credential
+	return nil
+}
+
+// Environment of run_as user (HOME, USER, LOGNAME). Empty if task doesn't run as other user
+func runAsEnv(task *pb.Task) []string {
+	userName, _, err := taskRunAs(task)
+	if err != nil || userName == "" {
+		return nil
+	}
+	u, err := lookupUser(userName)
+	if err != nil {
+		return nil // Reported by setRunAs
+	}
+	return []string{"HOME=" + u.HomeDir, "USER=" + u.Username, "LOGNAME=" + u.Username}
+}
+
+// Validate that user/group of task exist
+func validateRunAs(task *pb.Task) error {
+	_, err := runAsCredential(task)
+	return err
+}
+
+func runAsCredential(task *pb.Task) (*syscall.Credential, error) {
+	userName, groupName, err := taskRunAs(task)
+	if err != nil || (userName == "" && groupName == "") {
+		return nil, err
+	}
+	credential := &syscall.Credential{Uid: uint32(syscall.Getuid()), Gid: uint32(syscall.Getgid()), NoSetGroups: true}
+	if userName != "" {
+		u, err := lookupUser(userName)
+		if err != nil {
+			return nil, fmt.Errorf("errRunAsUser-%s", err.Error())
+		}
+		uid, _ := strconv.ParseUint(u.Uid, 10, 32)
+		gid, _ := strconv.ParseUint(u.Gid, 10, 32)
+		credential.Uid, credential.Gid = uint32(uid), uint32(gid)
+		if groupIDs, err := u.GroupIds(); err == nil { // Supplementary groups of user
+			credential.NoSetGroups = false
+			for _, groupID := range groupIDs {
+				if id, err := strconv.ParseUint(groupID, 10, 32); err == nil {
+					credential.Groups = append(credential.Groups, uint32(id))
+				}
+			}
+		}
+	}
+	if groupName != "" {
+		g, err := lookupGroup(groupName)
+		if err != nil {
+			return nil, fmt.Errorf("errRunAsGroup-%s", err.Error())
+		}
+		gid, _ := strconv.ParseUint(g.Gid, 10, 32)
+		credential.Gid = uint32(gid)
+	}
+	return credential, nil
+}
+
+// Lookup user by name or uid
+func lookupUser(name string) (*user.User, error) {
+	if _, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return user.LookupId(name)
+	}
+	return user.Lookup(name)
+}
+
+// Lookup group by name or gid
+func lookupGroup(name string) (*user.Group, error) {
+	if _, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return user.LookupGroupId(name)
+	}
+	return user.LookupGroup(name)
+}
@@ -0,0 +1,36 @@
+//go:build !windows
+
+package main
+
+import (
+	"os/user"
+	"strings"
+	"testing"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestRunAsEnv(t *testing.T) {
+	u, err := user.Current()
+	if err != nil {
+		t.Skipf("currentUser: %s", err.Error())
+	}
+	appRunAs := config.AppRunAs
+	config.AppRunAs = map[string]tRunAs{"sh": {User: u.Uid}}
+	defer func() { config.AppRunAs = appRunAs }()
+	for _, name := range []string{"HOME", "USER", "LOGNAME"} { // Service environment must be overridden
+		t.Setenv(name, "service")
+	}
+
+	env := make(map[string]string)
+	for _, v := range taskEnv(&pb.Task{App: "sh"}, nil, nil, tRunOptions{}) {
+		if name, value, ok := strings.Cut(v, "="); ok {
+			env[name] = value // Last value is used
+		}
+	}
+	for name, want := range map[string]string{"HOME": u.HomeDir, "USER": u.Username, "LOGNAME": u.Username} {
+		if env[name] != want {
+			t.Errorf("%s: %q, want: %q", name, env[name], want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+//go:build windows
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Run as user/group is not supported on Windows
+func setRunAs(cmd *exec.Cmd, task *pb.Task) error {
+	return validateRunAs(task)
+}
+
+func runAsEnv(task *pb.Task) []string {
+	return nil
+}
+
+func validateRunAs(task *pb.Task) error {
+	userName, groupName, err := taskRunAs(task)
+	if err != nil {
+		return err
+	}
+	if userName != "" || groupName != "" {
+		return fmt.Errorf("errRunAs-notSupportedOnWindows")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/mmalcek/gscheduler/proto/go"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// History of task executions (runs). Newest runs are at the end of the slice.
+// Runs are changed while task is running, so get/list/previous return copies taken under lock.
+// History file is written by saver goroutine outside of lock, multiple changes are merged into one write.
+
+const STDERR_TAIL_SIZE = 4096
+
+type tRuns struct {
+	mutex      sync.RWMutex
+	runs       []*pb.Run
+	saveSignal chan struct{}
+	saveMutex  sync.Mutex // Serializes file writes
+}
+
+// Load runs history from file. Runs left in "running" state (service crashed) are marked as aborted
+func (r *tRuns) load() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	runsData, err := os.ReadFile(config.RunsFile)
+	if os.IsNotExist(err) {
+		return nil // History is created with first run
+	}
+	if err != nil {
+		return fmt.Errorf("openFile: %v", err.Error())
+	}
+	if err := yaml.Unmarshal(runsData, &r.runs); err != nil {
+		return fmt.Errorf("unmarshal: %v", err.Error())
+	}
+	for i := range r.runs {
+		if r.runs[i].State == "running" || r.runs[i].State == "queued" {
+			r.runs[i].State = "aborted"
+		}
+	}
+	return nil
+}
+
+// Create new run in "running" state. If workflowID is empty, run is root of new workflow instance
+func (r *tRuns) create(task *pb.Task, trigger string, workflowID string) *pb.Run {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	run := &pb.Run{
+		RunId:      uuid.New().String(),
+		TaskUuid:   task.GetUuid(),
+		Name:       task.GetName(),
+		Trigger:    trigger,
+		State:      "running",
+		StartTime:  time.Now().UnixMicro(),
+		ExitCode:   -1,
+		WorkflowId: workflowID,
+	}
+	if run.WorkflowId == "" {
+		run.WorkflowId = run.RunId
+	}
+	r.runs = append(r.runs, run)
+	r.save()
+	return run
+}
+
+// Set attempt number of run (each retry is new attempt)
+func (r *tRuns) setAttempt(run *pb.Run, attempt int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	run.Attempts = attempt
+}
+
+// Set state of unfinished run (queued, running)
+func (r *tRuns) setState(run *pb.Run, state string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	run.State = state
+}
+
+// Keep last STDERR_TAIL_SIZE bytes of stderr output in run
+func (r *tRuns) appendStderr(run *pb.Run, data []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	run.StderrTail += string(data)
+	if len(run.StderrTail) > STDERR_TAIL_SIZE {
+		run.StderrTail = run.StderrTail[len(run.StderrTail)-STDERR_TAIL_SIZE:]
+	}
+}
+
+// Set final state of run based on result
+func (r *tRuns) finish(run *pb.Run, result *pb.RunResult) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	switch result.GetReason() {
+	case pb.RunReason_REASON_SUCCESS:
+		run.State = "success"
+	case pb.RunReason_REASON_NON_ZERO_EXIT:
+		run.State = "failed"
+	case pb.RunReason_REASON_SKIPPED:
+		run.State = "skipped"
+	default:
+		run.State = "error"
+	}
+	run.ExitCode = result.GetExitCode()
+	run.Result = result
+	run.EndTime = time.Now().UnixMicro()
+	r.limit(run.GetTaskUuid())
+	r.save()
+}
+
+// Get copy of single run by ID
+func (r *tRuns) get(runID string) *pb.Run {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for i := range r.runs {
+		if r.runs[i].GetRunId() == runID {
+			return proto.Clone(r.runs[i]).(*pb.Run)
+		}
+	}
+	return nil
+}
+
+// Copy of last finished run of task except run with runID (nil if there is none)
+func (r *tRuns) previous(taskUUID string, runID string) *pb.Run {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for i := len(r.runs) - 1; i >= 0; i-- {
+		if r.runs[i].GetTaskUuid() == taskUUID && r.runs[i].GetRunId() != runID && r.runs[i].GetEndTime() != 0 {
+			return proto.Clone(r.runs[i]).(*pb.Run)
+		}
+	}
+	return nil
+}
+
+// List copies of runs of task (all tasks if taskUUID is empty), newest first
+func (r *tRuns) list(taskUUID string, offset, limit int64) *pb.Runs {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if limit < 1 {
+		limit = 50
+	}
+	list := &pb.Runs{Runs: make([]*pb.Run, 0)}
+	for i := len(r.runs) - 1; i >= 0; i-- {
+		if taskUUID != "" && r.runs[i].GetTaskUuid() != taskUUID {
+			continue
+		}
+		if list.Total >= offset && int64(len(list.Runs)) < limit {
+			list.Runs = append(list.Runs, proto.Clone(r.runs[i]).(*pb.Run))
+		}
+		list.Total++
+	}
+	return list
+}
+
+// Keep only last config.RunLimit runs of task
+func (r *tRuns) limit(taskUUID string) {
+	if config.RunLimit < 1 {
+		return // No limit
+	}
+	count := 0
+	for i := len(r.runs) - 1; i >= 0; i-- {
+		if r.runs[i].GetTaskUuid() != taskUUID {
+			continue
+		}
+		if count++; count > config.RunLimit {
+			r.runs = append(r.runs[:i], r.runs[i+1:]...)
+		}
+	}
+}
+
+// Request save of runs to file. Call with mutex locked
+func (r *tRuns) save() {
+	select {
+	case r.saveSignal <- struct{}{}:
+	default: // Save already pending
+	}
+}
+
+// Write runs to file on save request
+func (r *tRuns) saver() {
+	for range r.saveSignal {
+		r.write()
+	}
+}
+
+// Write pending save (or wait for running one) before service stop
+func (r *tRuns) flush() {
+	select {
+	case <-r.saveSignal:
+		r.write()
+	default:
+		r.saveMutex.Lock()
+		r.saveMutex.Unlock()
+	}
+}
+
+// Write runs to file. History is not critical so failure is only logged
+func (r *tRuns) write() {
+	if config.RunsFile == "" {
+		return
+	}
+	r.saveMutex.Lock()
+	defer r.saveMutex.Unlock()
+	r.mutex.RLock()
+	runsData, err := yaml.Marshal(r.runs)
+	r.mutex.RUnlock()
+	if err != nil {
+		logger.Errorf("runsFileMarshal: %s", err.Error())
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(config.RunsFile), os.ModePerm); err != nil {
+		logger.Errorf("runsFileDir: %s", err.Error())
+		return
+	}
+	if err := os.WriteFile(config.RunsFile, runsData, 0644); err != nil {
+		logger.Errorf("runsFileWrite: %s", err.Error())
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Timezone database for systems without it (Windows)
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule parser used for both validation and scheduling of tasks. Supports:
+//   - standard 5 fields spec "min hour dom month dow" and 6 fields spec with seconds "sec min hour dom month dow"
+//   - descriptors @yearly, @monthly, @weekly, @daily, @hourly, @every <duration>
+//   - timezone prefix CRON_TZ=<zone> (or TZ=<zone>), otherwise task timezone, config timezone or server local time
+//   - Quartz-like day of month L (last day), LW (last weekday), nW (weekday nearest to day n)
+//     and day of week nL (last weekday n of month), n#k (k-th weekday n of month)
+//
+// Schedules (except @every) are evaluated on wall clock of timezone. DST handling:
+//   - gap (clock jumps forward): time which doesn't exist runs shifted by length of gap (02:30 -> 03:30)
+//   - overlap (clock jumps back): time which occurs twice runs only once (first occurrence, second one only if
+//     scheduler was started between them)
+//
+// H in field of task schedule is replaced by value derived from hash of task UUID (same task always gets same value):
+// H (any value of field), H/n (every n starting at hashed offset), H(a-b) and H(a-b)/n (within range a-b).
+// Day of month H uses range 1-28 so task runs every month.
+//
+// Task with run_at runs once at given time (schedule must be empty). Runs of any schedule can be bounded by
+// start_after and end_before. Schedule without next run returns zero time (task expires - see cr.expire).
+
+var (
+	scheduleParser  = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	quartzDomRegexp = regexp.MustCompile(`^(L|LW|([1-9]|[12][0-9]|3[01])W)$`)
+	hashRegexp      = regexp.MustCompile(`^H(\(([0-9]+)-([0-9]+)\))?(/([0-9]+))?$`)
+	hashRanges      = [][2]int{{0, 59}, {0, 59}, {0, 23}, {1, 28}, {1, 12}, {0, 6}} // sec, min, hour, dom, month, dow
+	quartzDowRegexp = regexp.MustCompile(`^([0-7]|SUN|MON|TUE|WED|THU|FRI|SAT)(L|#[1-5])$`)
+	dowNames        = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+type tZonedSchedule struct {
+	base cron.Schedule // Schedule evaluated on wall clock (UTC)
+	loc  *time.Location
+}
+
+type tRunAtSchedule struct {
+	runAt time.Time
+}
+
+type tBoundedSchedule struct {
+	base  cron.Schedule
+	start time.Time // Zero = no bound
+	end   time.Time // Zero = no bound
+}
+
+type tQuartzSchedule struct {
+	base   cron.Schedule // Schedule with Quartz fields replaced by "*"
+	dom    string        // L, LW, nW (empty = not used)
+	dow    int           // Weekday of nL, n#k (-1 = not used)
+	dowNth int           // k of n#k (0 = last weekday of month - nL)
+}
+
+// Parse schedule of task (cron expression or run_at) including start_after/end_before bounds
+func taskSchedule(task *pb.Task) (cron.Schedule, error) {
+	var schedule cron.Schedule
+	if task.GetRunAt() != "" {
+		if task.GetSchedule() != "" {
+			return nil, fmt.Errorf("runAt and schedule can't be set both")
+		}
+		runAt, err := time.Parse(time.RFC3339, task.GetRunAt())
+		if err != nil {
+			return nil, fmt.Errorf("runAt: %s", err.Error())
+		}
+		schedule = &tRunAtSchedule{runAt: runAt}
+	} else {
+		spec, err := hashSchedule(task.GetSchedule(), task.GetUuid())
+		if err != nil {
+			return nil, err
+		}
+		if schedule, err = parseSchedule(spec, task.GetTimezone()); err != nil {
+			return nil, err
+		}
+	}
+	if task.GetStartAfter() == "" && task.GetEndBefore() == "" {
+		return schedule, nil
+	}
+	bounded := &tBoundedSchedule{base: schedule}
+	var err error
+	if task.GetStartAfter() != "" {
+		if bounded.start, err = time.Parse(time.RFC3339, task.GetStartAfter()); err != nil {
+			return nil, fmt.Errorf("startAfter: %s", err.Error())
+		}
+	}
+	if task.GetEndBefore() != "" {
+		if bounded.end, err = time.Parse(time.RFC3339, task.GetEndBefore()); err != nil {
+			return nil, fmt.Errorf("endBefore: %s", err.Error())
+		}
+	}
+	if !bounded.start.IsZero() && !bounded.end.IsZero() && !bounded.end.After(bounded.start) {
+		return nil, fmt.Errorf("endBefore must be after startAfter")
+	}
+	return bounded, nil
+}
+
+// Parse schedule in timezone (empty = config timezone or server local time). Schedule without next run is rejected
+func parseSchedule(spec string, timezone string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexAny(spec, " \t")
+		if i == -1 {
+			return nil, fmt.Errorf("missing fields after timezone")
+		}
+		if timezone != "" {
+			return nil, fmt.Errorf("timezone set in both schedule and task")
+		}
+		timezone, spec = spec[strings.Index(spec, "=")+1:i], strings.TrimSpace(spec[i:])
+	}
+	if timezone == "" {
+		timezone = config.Timezone
+	}
+	loc := time.Local
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("timezone: %s", err.Error())
+		}
+	}
+	schedule, err := parseWallSchedule(spec)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return schedule, nil // @every doesn't depend on wall clock
+	}
+	zoned := &tZonedSchedule{base: schedule, loc: loc}
+	if zoned.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("schedule never fires: %s", spec) // e.g. 30W or 30 of February
+	}
+	return zoned, nil
+}
+
+// Replace H fields of schedule by values derived from hash of seed (task UUID)
+func hashSchedule(spec string, seed string) (string, error) {
+	fields := strings.Fields(spec)
+	prefix := make([]string, 0, 1)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		prefix, fields = fields[:1], fields[1:]
+	}
+	if len(fields) != 5 && len(fields) != 6 {
+		return spec, nil // Descriptor or invalid spec (reported by parser)
+	}
+	ranges := hashRanges
+	if len(fields) == 5 {
+		ranges = hashRanges[1:] // Without seconds
+	}
+	for i := range fields {
+		parts := strings.Split(fields[i], ",")
+		for j := range parts {
+			match := hashRegexp.FindStringSubmatch(strings.ToUpper(parts[j]))
+			if match == nil {
+				continue
+			}
+			low, high := ranges[i][0], ranges[i][1]
+			if match[1] != "" {
+				low, _ = strconv.Atoi(match[2])
+				high, _ = strconv.Atoi(match[3])
+				if low < ranges[i][0] || high > ranges[i][1] || low > high {
+					return "", fmt.Errorf("hash range %s out of %d-%d", match[1], ranges[i][0], ranges[i][1])
+				}
+			}
+			h := fnv.New32a()
+			h.Write([]byte(fmt.Sprintf("%s:%d", seed, i))) // Different value for each field
+			if match[4] == "" {
+				parts[j] = strconv.Itoa(low + int(h.Sum32()%uint32(high-low+1)))
+				continue
+			}
+			step, _ := strconv.Atoi(match[5])
+			if step < 1 || step > high-low+1 {
+				return "", fmt.Errorf("hash step %s out of range", match[4])
+			}
+			parts[j] = fmt.Sprintf("%d-%d/%d", low+int(h.Sum32()%uint32(step)), high, step)
+		}
+		fields[i] = strings.Join(parts, ",")
+	}
+	return strings.Join(append(prefix, fields...), " "), nil
+}
+
+// Parse schedule without timezone
+func parseWallSchedule(spec string) (cron.Schedule, error) {
+	fields := strings.Fields(spec)
+	if strings.HasPrefix(spec, "@") || (len(fields) != 5 && len(fields) != 6) {
+		return scheduleParser.Parse(spec)
+	}
+	domIdx, dowIdx := 2, 4
+	if len(fields) == 6 {
+		domIdx, dowIdx = 3, 5
+	}
+	quartz := &tQuartzSchedule{dow: -1}
+	if dom := strings.ToUpper(fields[domIdx]); strings.ContainsAny(dom, "LW") {
+		if !quartzDomRegexp.MatchString(dom) {
+			return nil, fmt.Errorf("unsupported day of month: %s (L, LW, nW)", fields[domIdx])
+		}
+		quartz.dom = dom
+		fields[domIdx] = "*"
+	}
+	if dow := strings.ToUpper(fields[dowIdx]); strings.ContainsAny(dow, "L#") {
+		match := quartzDowRegexp.FindStringSubmatch(dow)
+		if match == nil {
+			return nil, fmt.Errorf("unsupported day of week: %s (nL, n#k)", fields[dowIdx])
+		}
+		if weekday, ok := dowNames[match[1]]; ok {
+			quartz.dow = weekday
+		} else {
+			quartz.dow, _ = strconv.Atoi(match[1])
+			quartz.dow %= 7 // 7 = Sunday
+		}
+		if strings.HasPrefix(match[2], "#") {
+			quartz.dowNth, _ = strconv.Atoi(match[2][1:])
+		}
+		fields[dowIdx] = "*"
+	}
+	base, err := scheduleParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return nil, err
+	}
+	if quartz.dom == "" && quartz.dow == -1 {
+		return base, nil
+	}
+	quartz.base = base
+	return quartz, nil
+}
+
+// Next time in location. Wall clock of t is converted to UTC, evaluated by base schedule and converted back
+func (s *tZonedSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.loc)
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	for i := 0; i < 1000; i++ {
+		if wall = s.base.Next(wall); wall.IsZero() {
+			return wall
+		}
+		for _, next := range fromWallClock(wall, s.loc) {
+			if next.After(t) {
+				return next
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// Convert wall clock (UTC) to times in location (sorted). Gap = shifted by gap length, overlap = both occurrences
+func fromWallClock(wall time.Time, loc *time.Location) []time.Time {
+	_, offBefore := wall.Add(-12 * time.Hour).In(loc).Zone()
+	_, offAfter := wall.Add(12 * time.Hour).In(loc).Zone()
+	before := wall.Add(-time.Duration(offBefore) * time.Second).In(loc)
+	after := wall.Add(-time.Duration(offAfter) * time.Second).In(loc)
+	sameWall := func(t time.Time) bool {
+		return t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Day() == wall.Day()
+	}
+	switch {
+	case offBefore == offAfter || !sameWall(after):
+		return []time.Time{before} // No transition, gap (offset before transition) or transition just after wall
+	case !sameWall(before):
+		return []time.Time{after}
+	case after.Before(before):
+		return []time.Time{after, before}
+	}
+	return []time.Time{before, after}
+}
+
+// Run time if it's after t (zero time otherwise)
+func (s *tRunAtSchedule) Next(t time.Time) time.Time {
+	if s.runAt.After(t) {
+		return s.runAt
+	}
+	return time.Time{}
+}
+
+// Next time of base schedule within bounds (start inclusive, end exclusive)
+func (s *tBoundedSchedule) Next(t time.Time) time.Time {
+	if !s.start.IsZero() && t.Before(s.start) {
+		t = s.start.Add(-time.Nanosecond) // Next() returns time after t
+	}
+	next := s.base.Next(t)
+	if !s.end.IsZero() && !next.Before(s.end) {
+		return time.Time{}
+	}
+	return next
+}
+
+// Next time matching base schedule and Quartz day fields (zero time if none found within 5 years)
+func (s *tQuartzSchedule) Next(t time.Time) time.Time {
+	limit := t.AddDate(5, 0, 0)
+	for t = s.base.Next(t); !t.IsZero() && t.Before(limit); t = s.base.Next(t) {
+		if s.matchDay(t) {
+			return t
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, t.Location()) // Skip to next day
+	}
+	return time.Time{}
+}
+
+func (s *tQuartzSchedule) matchDay(t time.Time) bool {
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	switch {
+	case s.dom == "L":
+		if t.Day() != lastDay {
+			return false
+		}
+	case s.dom == "LW":
+		day := lastDay
+		for time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() == time.Saturday ||
+			time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() == time.Sunday {
+			day--
+		}
+		if t.Day() != day {
+			return false
+		}
+	case s.dom != "":
+		day, _ := strconv.Atoi(strings.TrimSuffix(s.dom, "W"))
+		if day > lastDay || t.Day() != nearestWeekday(t, day, lastDay) {
+			return false
+		}
+	}
+	if s.dow != -1 {
+		if int(t.Weekday()) != s.dow {
+			return false
+		}
+		if s.dowNth == 0 && t.Day()+7 <= lastDay { // nL - last weekday of month
+			return false
+		}
+		if s.dowNth > 0 && (t.Day()-1)/7+1 != s.dowNth {
+			return false
+		}
+	}
+	return true
+}
+
+// Weekday nearest to day of month (never crosses month boundary)
+func nearestWeekday(t time.Time, day, lastDay int) int {
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseScheduleNext(t *testing.T) {
+	for _, test := range []struct {
+		spec     string
+		timezone string
+		from     string
+		want     []string // Next runs (RFC3339)
+	}{
+		{"0 0 L * *", "UTC", "2024-02-10T00:00:00Z", []string{"2024-02-29T00:00:00Z", "2024-03-31T00:00:00Z"}},
+		{"0 0 LW * *", "UTC", "2024-03-01T00:00:00Z", []string{"2024-03-29T00:00:00Z", "2024-04-30T00:00:00Z"}},
+		{"0 0 15W * *", "UTC", "2024-06-01T00:00:00Z", []string{"2024-06-14T00:00:00Z", "2024-07-15T00:00:00Z"}}, // Saturday -> Friday
+		{"0 0 1W * *", "UTC", "2024-05-31T12:00:00Z", []string{"2024-06-03T00:00:00Z"}},                          // Saturday 1st -> Monday
+		{"0 0 31W * *", "UTC", "2024-08-01T00:00:00Z", []string{"2024-08-30T00:00:00Z", "2024-10-31T00:00:00Z"}}, // Saturday -> Friday, September skipped
+		{"0 0 * * 5#3", "UTC", "2024-01-01T00:00:00Z", []string{"2024-01-19T00:00:00Z", "2024-02-16T00:00:00Z"}},
+		{"0 0 * * MON#1", "UTC", "2024-01-01T00:00:00Z", []string{"2024-02-05T00:00:00Z"}},
+		{"0 0 * * 5L", "UTC", "2024-01-01T00:00:00Z", []string{"2024-01-26T00:00:00Z", "2024-02-23T00:00:00Z"}},
+		{"30 2 * * *", "Europe/Prague", "2024-03-30T12:00:00Z", []string{"2024-03-31T01:30:00Z", "2024-04-01T00:30:00Z"}}, // Gap: 02:30 -> 03:30 CEST
+		{"30 2 * * *", "Europe/Prague", "2024-10-26T12:00:00Z", []string{"2024-10-27T00:30:00Z", "2024-10-28T01:30:00Z"}}, // Overlap: first occurrence only
+		{"30 2 * * *", "Europe/Prague", "2024-10-27T01:00:00Z", []string{"2024-10-27T01:30:00Z"}},                         // Started between occurrences
+		{"CRON_TZ=America/New_York 0 9 * * *", "", "2024-03-09T20:00:00Z", []string{"2024-03-10T13:00:00Z"}},              // EDT from 10.3.
+	} {
+		schedule, err := parseSchedule(test.spec, test.timezone)
+		if err != nil {
+			t.Errorf("%s: %s", test.spec, err.Error())
+			continue
+		}
+		next, _ := time.Parse(time.RFC3339, test.from)
+		for _, want := range test.want {
+			next = schedule.Next(next)
+			if got := next.UTC().Format(time.RFC3339); got != want {
+				t.Errorf("%s (%s) from %s: next: %s, want: %s", test.spec, test.timezone, test.from, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"0 0 30W 2 *", // Never fires
+		"0 0 30 2 *",
+		"0 0 32W * *",
+		"0 0 WL * *",
+		"0 0 * * 5#6",
+		"0 0 * * 8L",
+		"TZ=Europe/Prague",
+	} {
+		if _, err := parseSchedule(spec, "UTC"); err == nil {
+			t.Errorf("%s: no error", spec)
+		}
+	}
+}
+
+func TestHashSchedule(t *testing.T) {
+	for _, test := range []struct {
+		spec string
+		seed string
+		want string
+	}{
+		{"H H * * *", "backup", "41 18 * * *"},
+		{"H H * * *", "report", "1 14 * * *"},
+		{"0 H * * *", "backup", "0 18 * * *"}, // Same field, same value
+		{"H/15 * * * *", "backup", "11-59/15 * * * *"},
+		{"H/15 * * * *", "report", "1-59/15 * * * *"},
+		{"H(0-29)/15 H(9-17) * * *", "backup", "11-29/15 15 * * *"},
+		{"H(0-29)/15 H(9-17) * * *", "report", "1-29/15 14 * * *"},
+		{"H H H(1-5) * *", "backup", "41 18 4 * *"},
+		{"H H H H * *", "report", "1 2 3 9 * *"}, // With seconds
+		{"CRON_TZ=UTC H 3 * * *", "backup", "CRON_TZ=UTC 41 3 * * *"},
+		{"@daily", "backup", "@daily"},
+	} {
+		got, err := hashSchedule(test.spec, test.seed)
+		if err != nil {
+			t.Errorf("%s (%s): %s", test.spec, test.seed, err.Error())
+		} else if got != test.want {
+			t.Errorf("%s (%s): %q, want: %q", test.spec, test.seed, got, test.want)
+		}
+	}
+	for _, spec := range []string{"H(0-60) * * * *", "H(10-5) * * * *", "H/0 * * * *", "H(1-5)/6 * * * *", "0 0 H(0-29) * *"} {
+		if _, err := hashSchedule(spec, "backup"); err == nil {
+			t.Errorf("%s: no error", spec)
+		}
+	}
+}
+
+// Hashed values of many tasks stay within range and are spread over it
+func TestHashScheduleSpread(t *testing.T) {
+	for _, test := range []struct {
+		spec      string
+		field     int
+		low, high int
+	}{
+		{"H * * * *", 0, 0, 59},
+		{"0 0 H * *", 2, 1, 28},
+		{"H(9-17) * * * *", 0, 9, 17},
+		{"H/15 * * * *", 0, 0, 14}, // Start of step
+	} {
+		seen := map[int]bool{}
+		for i := 0; i < 1000; i++ {
+			spec, err := hashSchedule(test.spec, fmt.Sprintf("task%d", i))
+			if err != nil {
+				t.Fatalf("%s: %s", test.spec, err.Error())
+			}
+			value, err := strconv.Atoi(strings.Split(strings.Fields(spec)[test.field], "-")[0])
+			if err != nil || value < test.low || value > test.high {
+				t.Fatalf("%s: %s out of %d-%d", test.spec, spec, test.low, test.high)
+			}
+			seen[value] = true
+		}
+		if len(seen) != test.high-test.low+1 {
+			t.Errorf("%s: %d of %d values used", test.spec, len(seen), test.high-test.low+1)
+		}
+	}
+}
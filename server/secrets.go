@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Secrets store. Values are encrypted by AES-256-GCM and saved to secrets_file (name -> base64(nonce+ciphertext)).
+// Key (hex) is loaded from secrets_key file which is created on first use. Keep key file outside of tasks/secrets folder.
+// Tasks reference secrets in args/env as ${secret:name}, references are resolved right before execution.
+
+const SECRET_REDACTED = "*****"
+
+var (
+	secretRefRegexp  = regexp.MustCompile(`\$\{secret:([A-Za-z0-9_.-]+)\}`)
+	secretNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+type tSecrets struct {
+	mutex   sync.RWMutex
+	secrets map[string]string // name -> encrypted value
+	gcm     cipher.AEAD
+}
+
+// Load key and encrypted secrets
+func (s *tSecrets) load() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	key, err := loadSecretsKey(config.SecretsKey)
+	if err != nil {
+		return fmt.Errorf("secretsKey: %s", err.Error())
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("secretsCipher: %s", err.Error())
+	}
+	if s.gcm, err = cipher.NewGCM(block); err != nil {
+		return fmt.Errorf("secretsCipher: %s", err.Error())
+	}
+	secretsData, err := os.ReadFile(config.SecretsFile)
+	if os.IsNotExist(err) {
+		return nil // Created with first secret
+	}
+	if err != nil {
+		return fmt.Errorf("openFile: %s", err.Error())
+	}
+	if err := yaml.Unmarshal(secretsData, &s.secrets); err != nil {
+		return fmt.Errorf("unmarshal: %s", err.Error())
+	}
+	if s.secrets == nil {
+		s.secrets = make(map[string]string)
+	}
+	return nil
+}
+
+// Load AES-256 key from file (hex). If file doesn't exist new random key is created
+func loadSecretsKey(keyFile string) ([]byte, error) {
+	keyData, err := os.ReadFile(keyFile)
+	if os.IsNotExist(err) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(keyFile), os.ModePerm); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(keyFile, []byte(hex.EncodeToString(key)), 0600); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(keyData)))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("keyLength-32bytes")
+	}
+	return key, nil
+}
+
+func (s *tSecrets) set(name, value string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.gcm == nil {
+		return fmt.Errorf("secretsNotLoaded")
+	}
+	if !secretNameRegexp.MatchString(name) || len(name) > 128 {
+		return fmt.Errorf("errName-only[A-Za-z0-9_.-]max128chars")
+	}
+	if value == "" {
+		return fmt.Errorf("errValue-empty")
+	}
+	nonce := make([]byte, s.gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	s.secrets[name] = base64.StdEncoding.EncodeToString(s.gcm.Seal(nonce, nonce, []byte(value), []byte(name)))
+	return s.save()
+}
+
+func (s *tSecrets) delete(name string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.secrets[name]; !ok {
+		return fmt.Errorf("secretNotFound")
+	}
+	delete(s.secrets, name)
+	return s.save()
+}
+
+// Return sorted secret names (never values)
+func (s *tSecrets) names() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	names := make([]string, 0, len(s.secrets))
+	for name := range s.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Decrypt secret value. Call with mutex locked
+func (s *tSecrets) value(name string) (string, error) {
+	encrypted, ok := s.secrets[name]
+	if !ok || s.gcm == nil {
+		return "", fmt.Errorf("secretNotFound: %s", name)
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(data) < s.gcm.NonceSize() {
+		return "", fmt.Errorf("secretCorrupted: %s", name)
+	}
+	value, err := s.gcm.Open(nil, data[:s.gcm.NonceSize()], data[s.gcm.NonceSize():], []byte(name))
+	if err != nil {
+		return "", fmt.Errorf("secretDecrypt: %s", name)
+	}
+	return string(value), nil
+}
+
+// Replace ${secret:name} references in list of strings. Returns used secret values (for redaction)
+func (s *tSecrets) resolve(list []string) ([]string, []string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	resolved := make([]string, len(list))
+	values := make([]string, 0)
+	for i := range list {
+		var err error
+		resolved[i] = secretRefRegexp.ReplaceAllStringFunc(list[i], func(ref string) string {
+			value, e := s.value(secretRefRegexp.FindStringSubmatch(ref)[1])
+			if e != nil {
+				err = e
+				return ref
+			}
+			values = append(values, value)
+			return value
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return resolved, values, nil
+}
+
+// Save encrypted secrets to file. Call with mutex locked
+func (s *tSecrets) save() error {
+	secretsData, err := yaml.Marshal(s.secrets)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(config.SecretsFile), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(config.SecretsFile, secretsData, 0600)
+}
+
+// Replace secret values in data
+func redactSecrets(data []byte, values []string) []byte {
+	for _, value := range values {
+		data = bytes.ReplaceAll(data, []byte(value), []byte(SECRET_REDACTED))
+	}
+	return data
+}
+
+// Redact secrets in output read in chunks. End of chunk which can be start of secret is held back until next chunk
+type tRedactor struct {
+	values  []string
+	pending []byte
+}
+
+// Return redacted data which can be sent (without held back end)
+func (r *tRedactor) write(data []byte) []byte {
+	out := redactSecrets(append(r.pending, data...), r.values)
+	hold := 0
+	for _, value := range r.values {
+		for k := len(value) - 1; k > hold; k-- {
+			if k <= len(out) && bytes.HasPrefix([]byte(value), out[len(out)-k:]) {
+				hold = k
+				break
+			}
+		}
+	}
+	r.pending = append([]byte{}, out[len(out)-hold:]...)
+	return out[:len(out)-hold]
+}
+
+// Return held back data (end of output)
+func (r *tRedactor) flush() []byte {
+	out := redactSecrets(r.pending, r.values)
+	r.pending = nil
+	return out
+}
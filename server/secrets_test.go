@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactorSplitSecret(t *testing.T) {
+	secret := "s3cretValue"
+	output := "password=" + secret + " user=admin s3c " + secret
+	for split := 1; split < len(output); split++ {
+		redactor := &tRedactor{values: []string{secret, "other"}}
+		got := string(redactor.write([]byte(output[:split])))
+		got += string(redactor.write([]byte(output[split:])))
+		got += string(redactor.flush())
+		if want := strings.ReplaceAll(output, secret, SECRET_REDACTED); got != want {
+			t.Errorf("split %d: %q, want: %q", split, got, want)
+		}
+	}
+}
+
+func TestRedactorHoldsOnlySecretPrefix(t *testing.T) {
+	redactor := &tRedactor{values: []string{"s3cretValue"}}
+	if got := string(redactor.write([]byte("line without secret\n"))); got != "line without secret\n" {
+		t.Errorf("output held back: %q", got)
+	}
+	if got := string(redactor.write([]byte("start s3c"))); got != "start " {
+		t.Errorf("output: %q, want: \"start \"", got)
+	}
+	if got := string(redactor.flush()); got != "s3c" {
+		t.Errorf("flush: %q, want: \"s3c\"", got)
+	}
+}
@@ -12,25 +12,65 @@ import (
 )
 
 var (
-	config        = tConfig{ServerAddress: "127.0.0.1", ServerPort: "50051", LogLimit: -1, Apps: map[string]string{}}
-	scheduler     = tCron{cron: cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))}
+	config        = tConfig{ServerAddress: "127.0.0.1", ServerPort: "50051", LogLimit: -1, RunLimit: 100, MisfireGrace: 3600, CgroupRoot: "/sys/fs/cgroup/gscheduler", Apps: map[string]string{}}
+	scheduler     = tCron{cron: cron.New()} // Overlapping runs are handled by task concurrency policy
 	tasks         = &tTasks{tasks: make([]*pb.Task, 0)}
+	runs          = &tRuns{runs: make([]*pb.Run, 0), saveSignal: make(chan struct{}, 1)}
+	secrets       = &tSecrets{secrets: make(map[string]string)}
+	calendars     = &tCalendars{calendars: make(map[string]*tCalendar)}
+	dispatcher    = &tDispatcher{running: make(map[string]int)}
+	fireTimes     = &tFireTimes{times: make(map[string]int64)}
+	workflows     = &tWorkflows{instances: make(map[string]*tWorkflowRun)}
 	tasksCTX      = tTasksCtxMap{taskCtx: make(map[string]*tTaskState, 0)}
 	taskLog       = make(chan *pb.TaskLog, 100)
 	logWatchChans = tSyncChanMap{channels: make(map[string]chan interface{})} // Send taskLog to this chan
+	webhooks      *tWebhooks
+	alerts        *tAlerts
 )
 
 func (p *program) run() {
 	if err := config.loadConfig(); err != nil {
 		logger.Errorf("configLoadFailed: %s", err.Error())
 	}
-	config.fixConfigPaths()   // Fix paths in config (relative to absolute)
+	config.fixConfigPaths() // Fix paths in config (relative to absolute)
+	if _, err := time.LoadLocation(config.Timezone); err != nil {
+		logger.Errorf("configTimezone: %s", err.Error())
+		config.Timezone = "" // Server local time
+	}
+	if err := config.Webhooks.validate(); err != nil {
+		logger.Errorf("webhooksConfig: %s", err.Error())
+		config.Webhooks.Endpoints = nil // Webhooks disabled
+	}
+	for app, limits := range config.AppResources {
+		if err := validateLimits(&pb.ResourceLimits{MemoryMb: limits.MemoryMB, CpuPercent: limits.CPUPercent, CpuWeight: limits.CPUWeight,
+			OpenFiles: limits.OpenFiles, Processes: limits.Processes, Nice: limits.Nice}); err != nil {
+			logger.Errorf("appResources: %s, err: %s", app, err.Error())
+			delete(config.AppResources, app)
+		}
+	}
+	webhooks = newWebhooks(config.Webhooks)
+	alerts = newAlerts()
 	go tasksLogWatch(taskLog) // Watch tasks (stdOut,stdErr) channel. Send to logWatchChans and write to fileLog
+	// Calendars must be loaded before tasks (tasks reference calendars)
+	if err := calendars.load(); err != nil {
+		logger.Errorf("loadCalendars: %v", err.Error())
+	}
 	if err := tasks.load(); err != nil {
 		logger.Errorf("loadTasks: %v", err.Error())
 		p.Stop(nil)
 		os.Exit(1)
 	}
+	if err := runs.load(); err != nil {
+		logger.Errorf("loadRuns: %v", err.Error())
+	}
+	go runs.saver()
+	if err := fireTimes.load(); err != nil {
+		logger.Errorf("loadFireTimes: %v", err.Error())
+	}
+	go fireTimes.saver()
+	if err := secrets.load(); err != nil {
+		logger.Errorf("loadSecrets: %v", err.Error())
+	}
 	if err := scheduler.start(); err != nil {
 		logger.Errorf("cronStart: %v", err.Error())
 		p.Stop(nil)
@@ -58,6 +98,8 @@ func (p *program) Stop(s service.Service) error {
 	time.Sleep(1 * time.Second)
 	func() {
 		scheduler.stop(true)
+		runs.flush()
+		fireTimes.save()
 		if tasksLogFile != nil {
 			tasksLogFile.Close()
 		}
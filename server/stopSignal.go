@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Graceful stop of task process. When task context is done (timeout, force stop) process gets stop_signal and
+// SIGKILL only after kill_grace_period. Task without stop_signal and kill_grace_period is killed immediately.
+// Supported signals are defined per platform in stopSignals (Windows supports only SIGKILL, so kill_grace_period
+// requires stop_signal there and unsupported signal of task falls back to immediate kill).
+// Each run has its own process group (except Windows) and signals are sent to whole group, so children
+// of process (e.g. started by shell script) are stopped too and don't keep stdout/stderr open.
+
+// Set process group and cmd.Cancel to (graceful) stop. Returned function must be called when process finished (stops kill timer)
+func setStopSignal(cmd *exec.Cmd, task *pb.Task, run *pb.Run) func() {
+	setProcessGroup(cmd)
+	if task.GetStopSignal() == "" && task.GetKillGracePeriod() == 0 {
+		cmd.Cancel = func() error { return signalProcess(cmd, os.Kill) }
+		return func() {}
+	}
+	signalName, grace := task.GetStopSignal(), time.Duration(task.GetKillGracePeriod())*time.Second
+	if signalName == "" {
+		signalName = "SIGTERM"
+	}
+	stopSignal, ok := stopSignals[signalName]
+	if !ok { // Task loaded from file with signal not supported on this platform (e.g. SIGTERM on Windows)
+		cmd.Cancel = func() error {
+			taskLog <- genMsg(task, run, fmt.Sprintf("killSignal: SIGKILL (stopSignal %s not supported)", signalName), "info")
+			return signalProcess(cmd, os.Kill)
+		}
+		return func() {}
+	}
+	if grace == 0 {
+		grace = 10 * time.Second
+	}
+	var mutex sync.Mutex
+	var killTimer *time.Timer
+	finished := false
+	cmd.Cancel = func() error {
+		taskLog <- genMsg(task, run, fmt.Sprintf("stopSignal: %s, killIn: %s", signalName, grace), "info")
+		mutex.Lock()
+		defer mutex.Unlock()
+		killTimer = time.AfterFunc(grace, func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if finished {
+				return
+			}
+			taskLog <- genMsg(task, run, "killSignal: SIGKILL (grace period expired)", "info")
+			signalProcess(cmd, os.Kill)
+		})
+		return signalProcess(cmd, stopSignal)
+	}
+	return func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		finished = true
+		if killTimer != nil {
+			killTimer.Stop()
+		}
+	}
+}
+
+func validateStopSignal(task *pb.Task) error {
+	if _, ok := stopSignals[task.GetStopSignal()]; !ok && task.GetStopSignal() != "" {
+		return fmt.Errorf("errStopSignal-unsupported: %s", task.GetStopSignal())
+	}
+	if task.GetKillGracePeriod() < 0 || task.GetKillGracePeriod() > 3600 {
+		return fmt.Errorf("errKillGracePeriod-0-3600sec")
+	}
+	if _, ok := stopSignals["SIGTERM"]; !ok && task.GetStopSignal() == "" && task.GetKillGracePeriod() > 0 {
+		return fmt.Errorf("errKillGracePeriod-requiresStopSignal (default SIGTERM not supported)")
+	}
+	return nil
+}
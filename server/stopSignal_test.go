@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestValidateStopSignal(t *testing.T) {
+	windowsSignals := map[string]os.Signal{"SIGKILL": os.Kill}
+	for _, test := range []struct {
+		task    *pb.Task
+		signals map[string]os.Signal // Nil = signals of platform
+		valid   bool
+	}{
+		{&pb.Task{}, nil, true},
+		{&pb.Task{KillGracePeriod: 3601}, nil, false},
+		{&pb.Task{StopSignal: "SIGFOO"}, nil, false},
+		{&pb.Task{KillGracePeriod: 10}, windowsSignals, false}, // Default SIGTERM not supported
+		{&pb.Task{StopSignal: "SIGTERM", KillGracePeriod: 10}, windowsSignals, false},
+		{&pb.Task{StopSignal: "SIGKILL"}, windowsSignals, true},
+	} {
+		platformSignals := stopSignals
+		if test.signals != nil {
+			stopSignals = test.signals
+		}
+		err := validateStopSignal(test.task)
+		stopSignals = platformSignals
+		if (err == nil) != test.valid {
+			t.Errorf("%v: err: %v, want valid: %v", test.task, err, test.valid)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+//go:build windows
+
+package main
+
+import "os"
+
+// Signals which can be used as task stop_signal (Windows can't send other signals to process)
+var stopSignals = map[string]os.Signal{
+	"SIGKILL": os.Kill,
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"sync"
-	"time"
 )
 
 // Array of contexts for running tasks
@@ -14,15 +13,102 @@ type tTasksCtxMap struct {
 }
 
 type tTaskState struct {
-	ctx    context.Context
-	cancel context.CancelFunc
+	ctx     context.Context
+	cancel  context.CancelFunc // Cancel all running runs of task
+	running int64              // Number of running runs (more than 1 only for "allow" policy)
+	queue   []chan bool        // Runs waiting for slot (true = start, false = dropped)
 }
 
-func (c *tTasksCtxMap) add(uuid string, timeout int64) {
+// Acquire slot for run of task according to concurrency policy.
+// Returns action (start, skip, parallel, queue, replace) and channel to wait on for queue/replace
+func (c *tTasksCtxMap) acquire(uuid string, policy string, limit int64) (string, chan bool) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	ctx, can := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
-	c.taskCtx[uuid] = &tTaskState{ctx: ctx, cancel: can}
+	state := c.taskCtx[uuid]
+	if state == nil {
+		ctx, can := context.WithCancel(context.Background())
+		c.taskCtx[uuid] = &tTaskState{ctx: ctx, cancel: can, running: 1}
+		return "start", nil
+	}
+	switch policy {
+	case "allow":
+		if limit > 0 && state.running >= limit {
+			return "skip", nil
+		}
+		state.running++
+		return "parallel", nil
+	case "queue-one", "queue-all":
+		if limit < 1 {
+			limit = 10
+		}
+		if policy == "queue-one" {
+			limit = 1
+		}
+		if int64(len(state.queue)) >= limit {
+			return "skip", nil
+		}
+		wait := make(chan bool, 1)
+		state.queue = append(state.queue, wait)
+		return "queue", wait
+	case "replace":
+		for i := range state.queue { // Newest run replaces also waiting runs
+			state.queue[i] <- false
+		}
+		wait := make(chan bool, 1)
+		state.queue = []chan bool{wait}
+		state.cancel()
+		return "replace", wait
+	default: // skip
+		return "skip", nil
+	}
+}
+
+// Release slot of finished run. If no run is running, first queued run is started with new context
+func (c *tTasksCtxMap) release(uuid string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	state := c.taskCtx[uuid]
+	if state == nil {
+		return
+	}
+	if state.running--; state.running > 0 {
+		return
+	}
+	state.cancel()
+	if len(state.queue) == 0 {
+		delete(c.taskCtx, uuid)
+		return
+	}
+	state.ctx, state.cancel = context.WithCancel(context.Background())
+	state.running = 1
+	state.queue[0] <- true
+	state.queue = state.queue[1:]
+}
+
+// Cancel all running runs of task and drop queued runs
+func (c *tTasksCtxMap) stop(uuid string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	state := c.taskCtx[uuid]
+	if state == nil {
+		return
+	}
+	for i := range state.queue {
+		state.queue[i] <- false
+	}
+	state.queue = nil
+	state.cancel()
+}
+
+// Return number of running and queued runs per task
+func (c *tTasksCtxMap) counts() map[string][2]int64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	counts := make(map[string][2]int64, len(c.taskCtx))
+	for uuid, state := range c.taskCtx {
+		counts[uuid] = [2]int64{state.running, int64(len(state.queue))}
+	}
+	return counts
 }
 
 func (c *tTasksCtxMap) get(uuid string) *tTaskState {
@@ -36,11 +122,3 @@ func (c *tTasksCtxMap) getAll() map[string]*tTaskState {
 	defer c.mutex.RUnlock()
 	return c.taskCtx
 }
-
-// Cancel context and delete from map
-func (c *tTasksCtxMap) cancel(uuid string) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	c.taskCtx[uuid].cancel()
-	delete(c.taskCtx, uuid)
-}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"net/mail"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -13,7 +14,6 @@ import (
 
 	"github.com/google/uuid"
 	pb "github.com/mmalcek/gscheduler/proto/go"
-	"github.com/robfig/cron/v3"
 )
 
 type tTasks struct {
@@ -50,6 +50,9 @@ func (tsk *tTasks) load() error {
 		if err := tasks.validateUUID(tsk.tasks[i].Uuid); err != nil {
 			return fmt.Errorf("taskUUID: %s, err: %s", tsk.tasks[i].GetName(), err.Error())
 		}
+		if err := tsk.validateDependencies(tsk.tasks[i]); err != nil {
+			return fmt.Errorf("validateDependencies: %s, err: %s", tsk.tasks[i].GetName(), err.Error())
+		}
 	}
 	return nil
 }
@@ -66,6 +69,18 @@ func (tsk *tTasks) get(uuid string) *pb.Task {
 	return nil
 }
 
+// Get task if it exists and is enabled (nil otherwise)
+func (tsk *tTasks) getEnabled(uuid string) *pb.Task {
+	tsk.mutex.RLock()
+	defer tsk.mutex.RUnlock()
+	for i := range tsk.tasks {
+		if tsk.tasks[i].Uuid == uuid && tsk.tasks[i].GetEnabled() {
+			return tsk.tasks[i]
+		}
+	}
+	return nil
+}
+
 // Get all tasks
 func (tsk *tTasks) getAll() []*pb.Task {
 	tsk.mutex.RLock()
@@ -80,6 +95,9 @@ func (tsk *tTasks) create(task *pb.Task) (taskUUID string, err error) {
 	if err := tsk.validateInput(task); err != nil {
 		return "", fmt.Errorf("validateInput: %s", err.Error())
 	}
+	if err := tsk.validateDependencies(task); err != nil {
+		return "", fmt.Errorf("validateDependencies: %s", err.Error())
+	}
 	/*
 		for i := range tsk.tasks {
 			if tsk.tasks[i].GetName() == task.GetName() {
@@ -90,6 +108,7 @@ func (tsk *tTasks) create(task *pb.Task) (taskUUID string, err error) {
 	task.Uuid = uuid.New().String()
 	task.CronId = 0
 	task.Enabled = false
+	task.NextRun, task.PrevRun = 0, 0
 	tsk.tasks = append(tsk.tasks, task)
 	tsk.saveTasks()
 	return task.Uuid, nil
@@ -100,6 +119,9 @@ func (tsk *tTasks) start(uuid string) error {
 	defer tsk.mutex.Unlock()
 	for i := range tsk.tasks {
 		if tsk.tasks[i].GetUuid() == uuid {
+			if schedule, err := taskSchedule(tsk.tasks[i]); err == nil && schedule.Next(time.Now()).IsZero() {
+				return fmt.Errorf("taskExpired") // run_at or end_before already passed
+			}
 			tsk.tasks[i].Enabled = true
 			if tsk.tasks[i].CronId != 0 {
 				return fmt.Errorf("taskAlreadyStarted")
@@ -124,7 +146,7 @@ func (tsk *tTasks) stop(tuuid string, force bool) error {
 			for lastTask.NextTask != "" && tasksCTX.get(lastTask.NextTask) != nil {
 				lastTask = tsk.get(lastTask.NextTask)
 			}
-			tasksCTX.get(lastTask.Uuid).cancel()
+			tasksCTX.stop(lastTask.Uuid)
 		} else { // wait in loop until task is finished
 			taskRunning := true
 			for taskRunning {
@@ -146,6 +168,7 @@ func (tsk *tTasks) stop(tuuid string, force bool) error {
 			scheduler.remove(tsk.tasks[i].CronId)
 			tsk.tasks[i].CronId = 0
 			tsk.tasks[i].Enabled = false
+			fireTimes.delete(tuuid) // Runs missed while task is stopped are not caught up
 			tsk.saveTasks()
 			taskLog <- &pb.TaskLog{Name: tsk.tasks[i].Name, Tags: tsk.tasks[i].Tags, Uuid: tsk.tasks[i].Uuid, Message: "taskStop", Type: "sys", Timestamp: time.Now().UnixMicro()}
 			return nil
@@ -161,6 +184,9 @@ func (tsk *tTasks) update(task *pb.Task) error {
 	if err := tsk.validateInput(task); err != nil {
 		return fmt.Errorf("validateInput: %s, err: %s", task.GetName(), err.Error())
 	}
+	if err := tsk.validateDependencies(task); err != nil {
+		return fmt.Errorf("validateDependencies: %s, err: %s", task.GetName(), err.Error())
+	}
 	/*
 		for i := range tsk.tasks {
 			if tsk.tasks[i].GetName() == task.GetName() && tsk.tasks[i].GetUuid() != task.GetUuid() {
@@ -177,6 +203,7 @@ func (tsk *tTasks) update(task *pb.Task) error {
 			tsk.tasks[i] = task // Replace task
 			tsk.tasks[i].CronId = 0
 			tsk.tasks[i].Enabled = false
+			tsk.tasks[i].NextRun, tsk.tasks[i].PrevRun = 0, 0
 			tsk.saveTasks()
 			return nil
 		}
@@ -197,6 +224,13 @@ func (tsk *tTasks) delete(uuid string) error {
 			if tsk.tasks[i].CronId != 0 || tsk.tasks[i].Enabled {
 				return fmt.Errorf("taskMustBeStopped")
 			}
+			for j := range tsk.tasks {
+				for _, dep := range tsk.tasks[j].GetDependsOn() {
+					if dep.GetTaskUuid() == uuid {
+						return fmt.Errorf("taskHasDependents: %s", tsk.tasks[j].GetUuid())
+					}
+				}
+			}
 			scheduler.remove(tsk.tasks[i].CronId)
 			tsk.tasks = append(tsk.tasks[:i], tsk.tasks[i+1:]...)
 			tsk.saveTasks()
@@ -264,6 +298,38 @@ func (tsk *tTasks) validateInput(task *pb.Task) error {
 			return fmt.Errorf("errNextTask-%s", err.Error())
 		}
 	}
+	// Validate hook tasks
+	for _, hook := range []string{task.GetOnFailureTask(), task.GetOnFinishTask()} {
+		if hook == "" {
+			continue
+		}
+		if err := tsk.validateUUID(hook); err != nil {
+			return fmt.Errorf("errHookTask-%s", err.Error())
+		}
+		if hook == task.GetUuid() {
+			return fmt.Errorf("errHookTask-self")
+		}
+	}
+	// Validate alert emails
+	for _, email := range task.GetAlertEmails() {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("errAlertEmails-%s", err.Error())
+		}
+	}
+	// Validate environment variables
+	if err := validateEnv(task.GetEnv()); err != nil {
+		return err
+	}
+	// Validate calendars
+	for _, name := range append(task.GetExcludeCalendars(), task.GetIncludeCalendars()...) {
+		if !calendars.exists(name) {
+			return fmt.Errorf("errCalendars-notFound: %s", name)
+		}
+	}
+	// Validate templates (args, work_dir, env, stdin)
+	if err := validateTemplates(task); err != nil {
+		return err
+	}
 	// Validate description
 	matchDesc, err := regexp.MatchString(`^[A-Za-z0-9()_ +-=.]+$|^$`, task.GetDescription())
 	if err != nil {
@@ -276,15 +342,62 @@ func (tsk *tTasks) validateInput(task *pb.Task) error {
 		return fmt.Errorf("errDescription-max256chars")
 	}
 	// Validate schedule
-	_, err = cron.ParseStandard(task.GetSchedule())
+	_, err = taskSchedule(task)
 	if err != nil {
 		return fmt.Errorf("errSchedule-%s", err.Error())
 
 	}
+	// Validate expire action
+	switch task.GetExpireAction() {
+	case "", "disable", "delete":
+	default:
+		return fmt.Errorf("errExpireAction-disable|delete")
+	}
 	// Validate timeout
 	if task.GetTimeout() < 1 {
 		return fmt.Errorf("errTimeout-min1sec")
 	}
+	// Validate concurrency policy
+	switch task.GetConcurrencyPolicy() {
+	case "", "skip", "queue-one", "queue-all", "replace", "allow":
+	default:
+		return fmt.Errorf("errConcurrencyPolicy-skip|queue-one|queue-all|replace|allow")
+	}
+	if task.GetConcurrencyLimit() < 0 {
+		return fmt.Errorf("errConcurrencyLimit-negative")
+	}
+	// Validate misfire policy
+	switch task.GetMisfirePolicy() {
+	case "", "ignore", "run_once", "run_all":
+	default:
+		return fmt.Errorf("errMisfirePolicy-ignore|run_once|run_all")
+	}
+	if task.GetMisfireLimit() < 0 || task.GetMisfireGrace() < 0 {
+		return fmt.Errorf("errMisfire-negativeValue")
+	}
+	// Validate stop signal
+	if err := validateStopSignal(task); err != nil {
+		return err
+	}
+	// Validate resource limits
+	if err := validateLimits(task.GetLimits()); err != nil {
+		return err
+	}
+	// Validate run as user/group
+	if err := validateRunAs(task); err != nil {
+		return err
+	}
+	// Validate jitter
+	if task.GetJitter() < 0 {
+		return fmt.Errorf("errJitter-negative")
+	}
+	if task.GetJitter() > 86400 {
+		return fmt.Errorf("errJitter-max86400sec")
+	}
+	// Validate retry policy
+	if err := validateRetry(task.GetRetry()); err != nil {
+		return err
+	}
 	// Validate app
 	if task.GetApp() == "" {
 		return fmt.Errorf("errApp-empty")
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Task with template set has args, work_dir, env values and stdin rendered by text/template right before execution
+// (before secrets are resolved), e.g. --date={{.ScheduledTime.Format "2006-01-02"}}. Templates are opt-in, so values
+// of other tasks (e.g. docker ps --format '{{.Names}}') are used as they are.
+// ExecCmd is rendered the same way with trigger "execCmd" (RunID, Attempt and PrevRun are empty).
+
+type tTemplateData struct {
+	ScheduledTime time.Time
+	RunID         string
+	Attempt       int64
+	Trigger       string
+	Task          *pb.Task
+	PrevRun       *pb.Run // Last finished run of task (empty if there is none, Result is never nil)
+}
+
+type tRenderedTask struct {
+	args    []string
+	workDir string
+	env     map[string]string
+	stdin   string
+}
+
+// Data of run for templates
+func templateData(task *pb.Task, run *pb.Run, opts tRunOptions) *tTemplateData {
+	scheduledTime := opts.scheduledTime
+	if scheduledTime.IsZero() {
+		scheduledTime = time.Now()
+	}
+	prevRun := runs.previous(task.GetUuid(), run.GetRunId())
+	if prevRun == nil {
+		prevRun = &pb.Run{}
+	}
+	if prevRun.Result == nil { // No previous run or run finished without result
+		prevRun.Result = &pb.RunResult{}
+	}
+	return &tTemplateData{
+		ScheduledTime: scheduledTime,
+		RunID:         run.GetRunId(),
+		Attempt:       run.GetAttempts(),
+		Trigger:       opts.trigger,
+		Task:          task,
+		PrevRun:       prevRun,
+	}
+}
+
+// Render templates of task (values are copied as they are if task template is not set)
+func renderTask(task *pb.Task, data *tTemplateData) (*tRenderedTask, error) {
+	var err error
+	render := renderTemplate
+	if !task.GetTemplate() {
+		render = func(_ string, text string, _ *tTemplateData) (string, error) { return text, nil }
+	}
+	rendered := &tRenderedTask{args: make([]string, len(task.GetArgs())), env: make(map[string]string, len(task.GetEnv()))}
+	for i, arg := range task.GetArgs() {
+		if rendered.args[i], err = render(fmt.Sprintf("args[%d]", i), arg, data); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range task.GetEnv() {
+		if rendered.env[k], err = render("env."+k, v, data); err != nil {
+			return nil, err
+		}
+	}
+	if rendered.workDir, err = render("work_dir", task.GetWorkDir(), data); err != nil {
+		return nil, err
+	}
+	if rendered.stdin, err = render("stdin", task.GetStdin(), data); err != nil {
+		return nil, err
+	}
+	return rendered, nil
+}
+
+func renderTemplate(name string, text string, data *tTemplateData) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// Render templates with sample data to catch syntax errors and unknown fields
+func validateTemplates(task *pb.Task) error {
+	sample := &tTemplateData{ScheduledTime: time.Now(), Task: task, PrevRun: &pb.Run{Result: &pb.RunResult{}}}
+	if _, err := renderTask(task, sample); err != nil {
+		return fmt.Errorf("errTemplate-%s", err.Error())
+	}
+	return nil
+}
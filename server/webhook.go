@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Webhook notifications. Lifecycle events are picked from taskLog (tasksLogWatch) and put to bounded queue of endpoint,
+// each endpoint has own queue and worker so slow endpoint never blocks tasksLogWatch or other endpoints
+// (events are dropped if queue is full).
+// Endpoints with "default: true" receive events of all tasks. Task can override endpoints by tag
+// "webhook: <name>,<name>" (only listed endpoints are used) or disable webhooks by tag "webhook: none".
+
+const WEBHOOK_SIGNATURE_HEADER = "X-Gscheduler-Signature"
+
+var webhookRetryDelay = time.Second // Delay before first retry, doubles with each retry
+
+type (
+	tWebhooksConfig struct {
+		QueueSize int                  `yaml:"queue_size"` // Queue size of each endpoint
+		Endpoints map[string]tEndpoint `yaml:"endpoints"`
+	}
+	tEndpoint struct {
+		URL        string   `yaml:"url"`
+		Secret     string   `yaml:"secret"`      // If set, payload is signed by HMAC-SHA256 (header X-Gscheduler-Signature: sha256=<hex>)
+		Events     []string `yaml:"events"`      // started, success, failed, timeout, skipped (empty = all)
+		Default    bool     `yaml:"default"`     // Send events of tasks without "webhook" tag
+		Timeout    int64    `yaml:"timeout"`     // Request timeout in seconds (default 10)
+		MaxRetries int64    `yaml:"max_retries"` // Retries of failed request, delay doubles from 1s (default 3)
+	}
+	tWebhooks struct {
+		queues map[string]chan *tWebhookJob // Endpoint name => queue
+		client *http.Client
+	}
+	tWebhookJob struct {
+		endpoint tEndpoint
+		payload  []byte
+	}
+	tWebhookPayload struct {
+		Event     string            `json:"event"`
+		TaskUUID  string            `json:"task_uuid"`
+		TaskName  string            `json:"task_name"`
+		Tags      map[string]string `json:"tags"`
+		RunID     string            `json:"run_id"`
+		Attempt   int64             `json:"attempt"`
+		Message   string            `json:"message"`
+		Timestamp int64             `json:"timestamp"`
+		Result    *tWebhookResult   `json:"result,omitempty"`
+	}
+	tWebhookResult struct {
+		ExitCode   int64  `json:"exit_code"`
+		Signal     string `json:"signal"`
+		DurationMs int64  `json:"duration_ms"`
+		Reason     string `json:"reason"`
+	}
+)
+
+// Create queue and start worker for each endpoint
+func newWebhooks(webhooksConfig tWebhooksConfig) *tWebhooks {
+	queueSize := webhooksConfig.QueueSize
+	if queueSize < 1 {
+		queueSize = 100
+	}
+	w := &tWebhooks{queues: make(map[string]chan *tWebhookJob), client: &http.Client{}}
+	for name := range webhooksConfig.Endpoints {
+		w.queues[name] = make(chan *tWebhookJob, queueSize)
+		go w.worker(name, w.queues[name])
+	}
+	return w
+}
+
+// Queue webhooks for task log message (if message is lifecycle event). Never blocks
+func (w *tWebhooks) notify(data *pb.TaskLog) {
+	event := webhookEvent(data)
+	if event == "" {
+		return
+	}
+	endpoints := webhookEndpoints(data.GetTags())
+	if len(endpoints) == 0 {
+		return
+	}
+	var result *tWebhookResult
+	if data.GetResult() != nil {
+		result = &tWebhookResult{
+			ExitCode:   data.GetResult().GetExitCode(),
+			Signal:     data.GetResult().GetSignal(),
+			DurationMs: data.GetResult().GetDurationMs(),
+			Reason:     data.GetResult().GetReason().String(),
+		}
+	}
+	payload, err := json.Marshal(tWebhookPayload{
+		Event:     event,
+		TaskUUID:  data.GetUuid(),
+		TaskName:  data.GetName(),
+		Tags:      data.GetTags(),
+		RunID:     data.GetRunId(),
+		Attempt:   data.GetAttempt(),
+		Message:   data.GetMessage(),
+		Timestamp: data.GetTimestamp(),
+		Result:    result,
+	})
+	if err != nil {
+		logger.Errorf("webhookMarshal: %s", err.Error())
+		return
+	}
+	for name, endpoint := range endpoints {
+		if !endpoint.wants(event) {
+			continue
+		}
+		queue, ok := w.queues[name]
+		if !ok {
+			continue
+		}
+		select {
+		case queue <- &tWebhookJob{endpoint: endpoint, payload: payload}:
+		default:
+			logger.Warningf("webhookQueueFull: %s, event dropped: %s %s", name, event, data.GetUuid())
+		}
+	}
+}
+
+// Send queued webhooks of endpoint one by one
+func (w *tWebhooks) worker(name string, queue chan *tWebhookJob) {
+	for job := range queue {
+		if err := w.send(job.endpoint, job.payload); err != nil {
+			logger.Errorf("webhook: %s, err: %s", name, err.Error())
+		}
+	}
+}
+
+// Post payload to endpoint, retry failed request with exponential backoff
+func (w *tWebhooks) send(endpoint tEndpoint, payload []byte) error {
+	maxRetries := endpoint.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 3
+	}
+	timeout := time.Duration(endpoint.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	delay := webhookRetryDelay
+	var err error
+	for attempt := int64(0); attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+		if err = w.post(endpoint, payload, timeout); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (w *tWebhooks) post(endpoint tEndpoint, payload []byte, timeout time.Duration) error {
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if endpoint.Secret != "" {
+		req.Header.Set(WEBHOOK_SIGNATURE_HEADER, "sha256="+webhookSignature(endpoint.Secret, payload))
+	}
+	client := *w.client
+	client.Timeout = timeout
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("status: %s", resp.Status)
+	}
+	return nil
+}
+
+// HMAC-SHA256 of payload (hex)
+func webhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Map task log message to lifecycle event (empty = not an event)
+func webhookEvent(data *pb.TaskLog) string {
+	if data.GetUuid() == "" {
+		return "" // Not a task (execCmd)
+	}
+	if data.GetType() == "info" && data.GetMessage() == "started" {
+		return "started"
+	}
+	if data.GetResult() == nil {
+		return ""
+	}
+	switch data.GetResult().GetReason() {
+	case pb.RunReason_REASON_SUCCESS:
+		return "success"
+	case pb.RunReason_REASON_TIMEOUT:
+		return "timeout"
+	case pb.RunReason_REASON_SKIPPED:
+		return "skipped"
+	default:
+		return "failed"
+	}
+}
+
+// Return endpoints for task tags. Tag "webhook" (comma separated names) overrides default endpoints
+func webhookEndpoints(tags map[string]string) map[string]tEndpoint {
+	endpoints := make(map[string]tEndpoint)
+	if names, ok := tags["webhook"]; ok {
+		for _, name := range strings.Split(names, ",") {
+			if endpoint, ok := config.Webhooks.Endpoints[strings.TrimSpace(name)]; ok {
+				endpoints[strings.TrimSpace(name)] = endpoint
+			}
+		}
+		return endpoints // "none" (or unknown name) = no endpoints
+	}
+	for name, endpoint := range config.Webhooks.Endpoints {
+		if endpoint.Default {
+			endpoints[name] = endpoint
+		}
+	}
+	return endpoints
+}
+
+func (e tEndpoint) wants(event string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for i := range e.Events {
+		if e.Events[i] == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate webhooks config
+func (c *tWebhooksConfig) validate() error {
+	for name, endpoint := range c.Endpoints {
+		if !strings.HasPrefix(endpoint.URL, "http://") && !strings.HasPrefix(endpoint.URL, "https://") {
+			return fmt.Errorf("errWebhook-%s-url", name)
+		}
+		if endpoint.MaxRetries < 0 || endpoint.Timeout < 0 {
+			return fmt.Errorf("errWebhook-%s-negativeValue", name)
+		}
+		for _, event := range endpoint.Events {
+			switch event {
+			case "started", "success", "failed", "timeout", "skipped":
+			default:
+				return fmt.Errorf("errWebhook-%s-events-started|success|failed|timeout|skipped", name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func startedLog(taskUUID string) *pb.TaskLog {
+	return &pb.TaskLog{Uuid: taskUUID, Name: "test", RunId: "run-" + taskUUID, Type: "info", Message: "started"}
+}
+
+// Set webhooks config for test, previous config is restored after test
+func setWebhooksConfig(t *testing.T, webhooksConfig tWebhooksConfig) {
+	previous := config.Webhooks
+	t.Cleanup(func() { config.Webhooks = previous })
+	config.Webhooks = webhooksConfig
+}
+
+func TestWebhookSignature(t *testing.T) {
+	type tRequest struct {
+		signature string
+		body      []byte
+	}
+	requests := make(chan tRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- tRequest{signature: r.Header.Get(WEBHOOK_SIGNATURE_HEADER), body: body}
+	}))
+	defer srv.Close()
+	setWebhooksConfig(t, tWebhooksConfig{Endpoints: map[string]tEndpoint{
+		"signed": {URL: srv.URL, Secret: "topSecret", Default: true},
+	}})
+	newWebhooks(config.Webhooks).notify(startedLog("signature"))
+
+	select {
+	case req := <-requests:
+		mac := hmac.New(sha256.New, []byte("topSecret"))
+		mac.Write(req.body)
+		if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); req.signature != want {
+			t.Errorf("signature: %q, want: %q", req.signature, want)
+		}
+		payload := tWebhookPayload{}
+		if err := json.Unmarshal(req.body, &payload); err != nil {
+			t.Fatalf("unmarshal: %s", err.Error())
+		}
+		if payload.Event != "started" || payload.TaskUUID != "signature" {
+			t.Errorf("payload: %+v", payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not received")
+	}
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	webhookRetryDelay = 20 * time.Millisecond
+	defer func() { webhookRetryDelay = time.Second }()
+	var calls, failures int32 = 0, 2
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= atomic.LoadInt32(&failures) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+	w := &tWebhooks{client: &http.Client{}}
+
+	start := time.Now()
+	if err := w.send(tEndpoint{URL: srv.URL, MaxRetries: 3}, []byte("{}")); err != nil {
+		t.Fatalf("send: %s", err.Error())
+	}
+	if calls != 3 {
+		t.Errorf("calls: %d, want: 3", calls)
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond { // 20ms + 40ms
+		t.Errorf("elapsed: %s, want backoff at least 60ms", elapsed)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	atomic.StoreInt32(&failures, 100)
+	if err := w.send(tEndpoint{URL: srv.URL, MaxRetries: 2}, []byte("{}")); err == nil {
+		t.Error("send to failing endpoint returned no error")
+	}
+	if calls != 3 {
+		t.Errorf("calls: %d, want: 3 (first attempt + 2 retries)", calls)
+	}
+}
+
+func TestWebhookQueueFull(t *testing.T) {
+	received := make(chan struct{}, 10)
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+	var fastCalls int32
+	fast := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fastCalls, 1)
+	}))
+	defer fast.Close()
+	setWebhooksConfig(t, tWebhooksConfig{QueueSize: 1, Endpoints: map[string]tEndpoint{
+		"slow": {URL: slow.URL, Default: true},
+		"fast": {URL: fast.URL, Default: true},
+	}})
+	w := newWebhooks(config.Webhooks)
+	dropped := testLogger.count("webhookQueueFull: slow")
+	// Slow endpoint must not block other endpoints, fast one receives every event
+	waitFast := func(calls int32) {
+		deadline := time.Now().Add(5 * time.Second)
+		for atomic.LoadInt32(&fastCalls) < calls && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		if got := atomic.LoadInt32(&fastCalls); got != calls {
+			t.Fatalf("fast endpoint calls: %d, want: %d", got, calls)
+		}
+	}
+
+	w.notify(startedLog("queue1"))
+	select {
+	case <-received: // Worker of slow endpoint is blocked by first event
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not received")
+	}
+	waitFast(1)
+	w.notify(startedLog("queue2")) // Queued
+	waitFast(2)
+	w.notify(startedLog("queue3")) // Dropped
+	waitFast(3)
+	if count := testLogger.count("webhookQueueFull: slow") - dropped; count != 1 {
+		t.Errorf("dropped events: %d, want: 1", count)
+	}
+	if testLogger.count("webhookQueueFull: fast") != 0 {
+		t.Error("events of fast endpoint dropped")
+	}
+}
+
+func TestWebhookSkippedEvent(t *testing.T) {
+	task := &pb.Task{Uuid: "skipped", Name: "test"}
+	for _, msg := range []string{"skippedByPause", "skippedByCalendar: holidays"} {
+		if event := webhookEvent(genSkippedMsg(task, msg)); event != "skipped" {
+			t.Errorf("%s: event: %q, want: skipped", msg, event)
+		}
+	}
+}
+
+func TestWebhookValidate(t *testing.T) {
+	for _, test := range []struct {
+		endpoint tEndpoint
+		valid    bool
+	}{
+		{tEndpoint{URL: "https://example.com/hook", MaxRetries: 0}, true},
+		{tEndpoint{URL: "https://example.com/hook", MaxRetries: -1}, false},
+		{tEndpoint{URL: "https://example.com/hook", Timeout: -1}, false},
+		{tEndpoint{URL: "example.com/hook"}, false},
+	} {
+		webhooksConfig := tWebhooksConfig{Endpoints: map[string]tEndpoint{"test": test.endpoint}}
+		if err := webhooksConfig.validate(); (err == nil) != test.valid {
+			t.Errorf("%+v: err: %v, want valid: %v", test.endpoint, err, test.valid)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+// Workflows (DAG) - task can depend on several parent tasks (Task.DependsOn).
+// Workflow instance is started by finished run of task which has dependent tasks (root).
+// Dependent task is started once all its parents reachable from root are finished and all conditions are met,
+// otherwise it is skipped (and its dependent tasks are evaluated as well). Independent branches run in parallel.
+// All parents of task must be reachable from the same workflow roots (tasks without dependencies), otherwise join
+// would be started by each root separately. Such graphs are rejected.
+
+type tWorkflows struct {
+	mutex     sync.Mutex
+	instances map[string]*tWorkflowRun
+}
+
+type tWorkflowRun struct {
+	reachable map[string]bool   // Tasks that are part of this workflow instance
+	started   map[string]bool   // Tasks that were already started or skipped
+	done      map[string]string // Finished tasks and their outcome (success, failed, skipped)
+}
+
+// Process finished run of task and start dependent tasks which are ready
+func (w *tWorkflows) finished(task *pb.Task, run *pb.Run, result *pb.RunResult) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	workflowID := run.GetWorkflowId()
+	instance := w.instances[workflowID]
+	if instance == nil {
+		if len(tasks.children(task.GetUuid())) == 0 {
+			return // Task is not part of any workflow
+		}
+		instance = &tWorkflowRun{
+			reachable: tasks.reachable(task.GetUuid()),
+			started:   map[string]bool{task.GetUuid(): true},
+			done:      make(map[string]string),
+		}
+		w.instances[workflowID] = instance
+	}
+	switch result.GetReason() {
+	case pb.RunReason_REASON_SUCCESS:
+		instance.done[task.GetUuid()] = "success"
+	case pb.RunReason_REASON_SKIPPED:
+		instance.done[task.GetUuid()] = "skipped"
+	default:
+		instance.done[task.GetUuid()] = "failed"
+	}
+	// Evaluate until no more tasks can be skipped (skipped task can make other tasks ready)
+	for changed := true; changed; {
+		changed = false
+		for taskUUID := range instance.reachable {
+			if instance.started[taskUUID] {
+				continue
+			}
+			child := tasks.get(taskUUID)
+			if child == nil { // Deleted while workflow is running
+				instance.started[taskUUID] = true
+				instance.done[taskUUID] = "skipped"
+				changed = true
+				continue
+			}
+			ready, conditionsMet := instance.evaluate(child)
+			if !ready {
+				continue
+			}
+			instance.started[taskUUID] = true
+			if !conditionsMet {
+				instance.done[taskUUID] = "skipped"
+				taskLog <- genMsg(child, nil, fmt.Sprintf("workflowSkipped: %s", workflowID), "info")
+				changed = true
+				continue
+			}
+			scheduler.dispatch(1)
+			go func(child *pb.Task) {
+				defer scheduler.dispatch(-1)
+				scheduler.runTask(child, tRunOptions{trigger: "workflow", workflowID: workflowID})
+			}(child)
+		}
+	}
+	if len(instance.done) >= len(instance.reachable) {
+		delete(w.instances, workflowID)
+		taskLog <- genMsg(task, run, fmt.Sprintf("workflowDone: %s", workflowID), "info")
+	}
+}
+
+// Task is ready when all its parents in this workflow instance are finished.
+// Conditions are met when every parent outcome matches its dependency condition.
+func (wr *tWorkflowRun) evaluate(task *pb.Task) (ready bool, conditionsMet bool) {
+	conditionsMet = true
+	for _, dep := range task.GetDependsOn() {
+		if !wr.reachable[dep.GetTaskUuid()] {
+			continue // Parent is not part of this workflow instance
+		}
+		outcome, done := wr.done[dep.GetTaskUuid()]
+		if !done {
+			return false, false
+		}
+		switch dep.GetCondition() {
+		case "always":
+		case "on_failure":
+			conditionsMet = conditionsMet && outcome == "failed"
+		default: // on_success
+			conditionsMet = conditionsMet && outcome == "success"
+		}
+	}
+	return true, conditionsMet
+}
+
+// Return UUIDs of tasks which depend on task
+func (tsk *tTasks) children(taskUUID string) []string {
+	tsk.mutex.RLock()
+	defer tsk.mutex.RUnlock()
+	children := make([]string, 0)
+	for i := range tsk.tasks {
+		for _, dep := range tsk.tasks[i].GetDependsOn() {
+			if dep.GetTaskUuid() == taskUUID {
+				children = append(children, tsk.tasks[i].GetUuid())
+				break
+			}
+		}
+	}
+	return children
+}
+
+// Return UUIDs of all tasks reachable from task (including task itself)
+func (tsk *tTasks) reachable(taskUUID string) map[string]bool {
+	reachable := map[string]bool{taskUUID: true}
+	queue := []string{taskUUID}
+	for len(queue) > 0 {
+		for _, child := range tsk.children(queue[0]) {
+			if !reachable[child] {
+				reachable[child] = true
+				queue = append(queue, child)
+			}
+		}
+		queue = queue[1:]
+	}
+	return reachable
+}
+
+// Validate task dependencies and check that workflow graph (dependencies and nextTask) has no cycles. Call with tasks mutex locked
+func (tsk *tTasks) validateDependencies(task *pb.Task) error {
+	graph := make(map[string][]string) // parent -> children
+	addEdges := func(t *pb.Task) {
+		for _, dep := range t.GetDependsOn() {
+			graph[dep.GetTaskUuid()] = append(graph[dep.GetTaskUuid()], t.GetUuid())
+		}
+		if t.GetNextTask() != "" {
+			graph[t.GetUuid()] = append(graph[t.GetUuid()], t.GetNextTask())
+		}
+	}
+	for _, dep := range task.GetDependsOn() {
+		if err := tsk.validateUUID(dep.GetTaskUuid()); err != nil {
+			return fmt.Errorf("errDependsOn-%s", err.Error())
+		}
+		if dep.GetTaskUuid() == task.GetUuid() {
+			return fmt.Errorf("errDependsOn-self")
+		}
+		switch dep.GetCondition() {
+		case "", "on_success", "on_failure", "always":
+		default:
+			return fmt.Errorf("errDependsOn-condition-on_success|on_failure|always")
+		}
+		parentFound := false
+		for i := range tsk.tasks {
+			if tsk.tasks[i].GetUuid() == dep.GetTaskUuid() {
+				parentFound = true
+				break
+			}
+		}
+		if !parentFound {
+			return fmt.Errorf("errDependsOn-taskNotFound: %s", dep.GetTaskUuid())
+		}
+	}
+	for i := range tsk.tasks {
+		if tsk.tasks[i].GetUuid() != task.GetUuid() {
+			addEdges(tsk.tasks[i])
+		}
+	}
+	addEdges(task)
+	// Depth first search, state 1 = visiting, 2 = done
+	state := make(map[string]int)
+	var visit func(node string) error
+	visit = func(node string) error {
+		state[node] = 1
+		for _, child := range graph[node] {
+			switch state[child] {
+			case 1:
+				return fmt.Errorf("errDependsOn-cycle: %s -> %s", node, child)
+			case 0:
+				if err := visit(child); err != nil {
+					return err
+				}
+			}
+		}
+		state[node] = 2
+		return nil
+	}
+	for node := range graph {
+		if state[node] == 0 {
+			if err := visit(node); err != nil {
+				return err
+			}
+		}
+	}
+	return tsk.validateJoins(task)
+}
+
+// Check that parents of each task are reachable from the same roots. Call with tasks mutex locked
+func (tsk *tTasks) validateJoins(task *pb.Task) error {
+	parents := map[string][]*pb.Dependency{task.GetUuid(): task.GetDependsOn()}
+	for i := range tsk.tasks {
+		if tsk.tasks[i].GetUuid() != task.GetUuid() {
+			parents[tsk.tasks[i].GetUuid()] = tsk.tasks[i].GetDependsOn()
+		}
+	}
+	roots := make(map[string]map[string]bool) // Task -> roots from which task is reachable (graph has no cycles)
+	var taskRoots func(taskUUID string) map[string]bool
+	taskRoots = func(taskUUID string) map[string]bool {
+		if r, ok := roots[taskUUID]; ok {
+			return r
+		}
+		r := make(map[string]bool)
+		if len(parents[taskUUID]) == 0 {
+			r[taskUUID] = true
+		}
+		for _, dep := range parents[taskUUID] {
+			for root := range taskRoots(dep.GetTaskUuid()) {
+				r[root] = true
+			}
+		}
+		roots[taskUUID] = r
+		return r
+	}
+	for taskUUID, deps := range parents {
+		for i := 1; i < len(deps); i++ {
+			if !sameRoots(taskRoots(deps[0].GetTaskUuid()), taskRoots(deps[i].GetTaskUuid())) {
+				return fmt.Errorf("errDependsOn-parentsFromDifferentRoots: %s", taskUUID)
+			}
+		}
+	}
+	return nil
+}
+
+func sameRoots(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for root := range a {
+		if !b[root] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/mmalcek/gscheduler/proto/go"
+)
+
+func TestValidateJoins(t *testing.T) {
+	deps := func(parents ...string) []*pb.Dependency {
+		list := make([]*pb.Dependency, 0)
+		for _, parent := range parents {
+			list = append(list, &pb.Dependency{TaskUuid: parent})
+		}
+		return list
+	}
+	existing := &tTasks{tasks: []*pb.Task{
+		{Uuid: "root1"},
+		{Uuid: "root2"},
+		{Uuid: "a", DependsOn: deps("root1")},
+		{Uuid: "b", DependsOn: deps("root1")},
+		{Uuid: "c", DependsOn: deps("root2")},
+	}}
+	for _, test := range []struct {
+		name  string
+		task  *pb.Task
+		valid bool
+	}{
+		{"diamond", &pb.Task{Uuid: "join", DependsOn: deps("a", "b")}, true},
+		{"rootAndChild", &pb.Task{Uuid: "join", DependsOn: deps("root1", "a")}, true},
+		{"differentRoots", &pb.Task{Uuid: "join", DependsOn: deps("a", "c")}, false},
+		{"twoRoots", &pb.Task{Uuid: "join", DependsOn: deps("root1", "root2")}, false},
+		{"singleParent", &pb.Task{Uuid: "join", DependsOn: deps("c")}, true},
+	} {
+		if err := existing.validateJoins(test.task); (err == nil) != test.valid {
+			t.Errorf("%s: err: %v, want valid: %v", test.name, err, test.valid)
+		}
+	}
+}